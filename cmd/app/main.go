@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
@@ -14,6 +15,7 @@ import (
 	"avito/pkg/txs"
 
 	authRepository "avito/internal/infrastructure/auth"
+	"avito/internal/infrastructure/events"
 	productRepository "avito/internal/infrastructure/product"
 	pvzRepository "avito/internal/infrastructure/pvz"
 	receptionRepository "avito/internal/infrastructure/reception"
@@ -61,17 +63,46 @@ func main() {
 
 	logger.Info("Подключение к базе данных установлено")
 
-	txManager := txs.NewTxManager(db, logger)
+	replicaPools, err := connectReplicas(cfg)
+	if err != nil {
+		logger.Error("Ошибка при подключении к репликам базы данных", "error", err)
+		return
+	}
+
+	defer closePools(replicaPools)
+
+	replicaQueriers := make([]txs.Querier, 0, len(replicaPools))
+	for _, pool := range replicaPools {
+		replicaQueriers = append(replicaQueriers, pool)
+	}
+
+	replicas := txs.NewReplicaSet(replicaQueriers, txs.ReplicaConfig{
+		MaxLag:        cfg.ReplicaMaxLag,
+		CheckInterval: cfg.ReplicaCheckInterval,
+	}, logger)
+
+	if len(replicaPools) > 0 {
+		logger.Info("Чтение списка ПВЗ распределяется по репликам", "replicas", len(replicaPools))
+	}
+
+	txManager := txs.NewTxManager(db, logger, txs.RetryPolicy{
+		MaxAttempts: cfg.TxMaxAttempts,
+		BaseDelay:   cfg.TxRetryBaseDelay,
+		MaxDelay:    cfg.TxRetryMaxDelay,
+		Observer:    metrics.TxRetryObserver{},
+	})
 
 	authRepo := authRepository.NewRepository(db)
-	pvzRepo := pvzRepository.NewRepository(db)
+	pvzRepo := pvzRepository.NewRepository(db, replicas)
 	receptionRepo := receptionRepository.NewRepository(db)
 	productRepo := productRepository.NewRepository(db)
 
+	eventBroker := events.NewBroker(events.DefaultHistorySize, events.DefaultSubscriberSize)
+
 	authSvc := authService.NewService(authRepo, txManager, cfg.JWTSecret, cfg.TokenTTL)
 	pvzSvc := pvzService.NewService(pvzRepo, txManager)
-	receptionSvc := receptionService.NewService(receptionRepo, pvzRepo, txManager)
-	productSvc := productService.NewService(productRepo, receptionRepo, pvzRepo, txManager)
+	receptionSvc := receptionService.NewService(receptionRepo, pvzRepo, txManager, eventBroker)
+	productSvc := productService.NewService(productRepo, receptionRepo, pvzRepo, txManager, eventBroker)
 
 	prometheusServer := metrics.StartServer(cfg.PrometheusAddr)
 	logger.Info("Prometheus metrics доступны", "addr", cfg.PrometheusAddr+"/metrics")
@@ -82,6 +113,7 @@ func main() {
 		pvzSvc,
 		receptionSvc,
 		productSvc,
+		cfg.OpenAPIValidateResponses,
 		logger,
 	)
 
@@ -92,7 +124,14 @@ func main() {
 	}()
 	logger.Info("HTTP-сервер запущен", "addr", cfg.HTTPAddr)
 
-	grpcSrv := grpcServer.New(pvzSvc, logger)
+	healthCtx, stopHealthCheck := context.WithCancel(context.Background())
+	defer stopHealthCheck()
+
+	dbHealth := grpcServer.NewDatabaseHealth(db, cfg.HealthCheckInterval, logger)
+	go dbHealth.Run(healthCtx)
+	go replicas.Run(healthCtx)
+
+	grpcSrv := grpcServer.New(pvzSvc, receptionSvc, productSvc, eventBroker, dbHealth, authSvc, logger)
 
 	go func() {
 		if err := grpcSrv.Start(cfg.GRPCAddr); err != nil {
@@ -107,6 +146,10 @@ func main() {
 
 	logger.Info("Получен сигнал завершения, выполняем graceful shutdown...")
 
+	// Балансировщики должны перестать слать запросы до того, как сервер начнет их отклонять.
+	dbHealth.Shutdown()
+	stopHealthCheck()
+
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 	defer cancel()
 
@@ -114,7 +157,7 @@ func main() {
 		logger.Error("Ошибка при остановке HTTP-сервера", "error", err)
 	}
 
-	grpcSrv.Stop()
+	grpcSrv.Stop(ctx)
 
 	if err := prometheusServer.Shutdown(ctx); err != nil {
 		logger.Error("Ошибка при остановке Prometheus-сервера", "error", err)
@@ -122,3 +165,37 @@ func main() {
 
 	logger.Info("Приложение остановлено")
 }
+
+// connectReplicas создает пулы реплик из DB_REPLICA_URLS с теми же ограничениями соединений, что и у primary.
+// Доступность реплик здесь не проверяется: недоступные реплики исключает txs.ReplicaSet.
+func connectReplicas(cfg *config.Config) ([]*pgxpool.Pool, error) {
+	var pools []*pgxpool.Pool
+
+	for i, url := range cfg.ReplicaURLs() {
+		replicaConfig, err := pgxpool.ParseConfig(url)
+		if err != nil {
+			closePools(pools)
+			return nil, fmt.Errorf("ошибка при парсинге URL реплики %d: %w", i, err)
+		}
+
+		//nolint:gosec // cfg.DBMaxConn и cfg.DBMinConn всегда валидируются и ограничиваются в config.LoadConfig, переполнение невозможно
+		replicaConfig.MaxConns = int32(cfg.DBMaxConn)
+		replicaConfig.MinConns = int32(cfg.DBMinConn)
+
+		pool, err := pgxpool.NewWithConfig(context.Background(), replicaConfig)
+		if err != nil {
+			closePools(pools)
+			return nil, fmt.Errorf("ошибка при создании пула реплики %d: %w", i, err)
+		}
+
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
+
+func closePools(pools []*pgxpool.Pool) {
+	for _, pool := range pools {
+		pool.Close()
+	}
+}
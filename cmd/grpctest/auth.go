@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// login получает токен через HTTP API: по email и паролю, если они заданы, иначе по роли.
+func login(ctx context.Context, opts globalOptions) (string, error) {
+	path := "/dummyLogin"
+	body := map[string]string{"role": opts.role}
+
+	if opts.email != "" || opts.password != "" {
+		path = "/login"
+		body = map[string]string{"email": opts.email, "password": opts.password}
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+
+	url := strings.TrimRight(opts.httpAddr, "/") + path
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("ошибка при формировании запроса авторизации: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("ошибка при запросе %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("ошибка при чтении ответа %s: %w", path, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s вернул %d: %s", path, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var token string
+	if err := json.Unmarshal(respBody, &token); err != nil {
+		return "", fmt.Errorf("неожиданный ответ %s: %w", path, err)
+	}
+
+	return token, nil
+}
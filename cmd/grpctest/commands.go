@@ -0,0 +1,581 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	pbpvz "avito/internal/interfaces/grpc/pb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var productTypes = map[string]pbpvz.ProductType{
+	"electronics": pbpvz.ProductType_PRODUCT_TYPE_ELECTRONICS,
+	"электроника": pbpvz.ProductType_PRODUCT_TYPE_ELECTRONICS,
+	"clothes":     pbpvz.ProductType_PRODUCT_TYPE_CLOTHES,
+	"одежда":      pbpvz.ProductType_PRODUCT_TYPE_CLOTHES,
+	"shoes":       pbpvz.ProductType_PRODUCT_TYPE_SHOES,
+	"обувь":       pbpvz.ProductType_PRODUCT_TYPE_SHOES,
+}
+
+var receptionStatuses = map[string]pbpvz.ReceptionStatus{
+	"in_progress": pbpvz.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS,
+	"close":       pbpvz.ReceptionStatus_RECEPTION_STATUS_CLOSED,
+}
+
+var sortFields = map[string]pbpvz.PVZSortField{
+	"registrationDate": pbpvz.PVZSortField_PVZ_SORT_FIELD_REGISTRATION_DATE,
+	"receptionCount":   pbpvz.PVZSortField_PVZ_SORT_FIELD_RECEPTION_COUNT,
+	"lastActivity":     pbpvz.PVZSortField_PVZ_SORT_FIELD_LAST_ACTIVITY,
+}
+
+const undoCommand = "undo"
+
+// listFilterFlags - флаги фильтрации и сортировки команды list.
+type listFilterFlags struct {
+	cities           *string
+	statuses         *string
+	productTypes     *string
+	hasOpenReception *string
+	registeredFrom   *string
+	registeredTo     *string
+	sort             *string
+	order            *string
+}
+
+func addListFilterFlags(fs *flag.FlagSet) *listFilterFlags {
+	return &listFilterFlags{
+		cities:           fs.String("cities", "", "несколько городов через запятую"),
+		statuses:         fs.String("status", "", "статусы приемок через запятую: in_progress, close"),
+		productTypes:     fs.String("product-types", "", "типы товаров через запятую: electronics, clothes, shoes"),
+		hasOpenReception: fs.String("has-open-reception", "", "true - только ПВЗ с открытой приемкой, false - без нее"),
+		registeredFrom:   fs.String("registered-from", "", "начало диапазона дат регистрации ПВЗ (RFC3339)"),
+		registeredTo:     fs.String("registered-to", "", "конец диапазона дат регистрации ПВЗ (RFC3339)"),
+		sort:             fs.String("sort", "", "поле сортировки: registrationDate, receptionCount или lastActivity"),
+		order:            fs.String("order", "desc", "направление сортировки: asc или desc"),
+	}
+}
+
+// apply переносит значения флагов в запрос.
+func (f *listFilterFlags) apply(req *pbpvz.GetPVZListRequest) error {
+	req.Cities = splitList(*f.cities)
+
+	for _, name := range splitList(*f.statuses) {
+		status, ok := receptionStatuses[strings.ToLower(name)]
+		if !ok {
+			return newUsageError("неизвестный статус приемки %q, допустимы: in_progress, close", name)
+		}
+
+		req.ReceptionStatuses = append(req.ReceptionStatuses, status)
+	}
+
+	for _, name := range splitList(*f.productTypes) {
+		productType, err := parseProductType(name)
+		if err != nil {
+			return err
+		}
+
+		req.ProductTypes = append(req.ProductTypes, productType)
+	}
+
+	if *f.hasOpenReception != "" {
+		hasOpen, err := strconv.ParseBool(*f.hasOpenReception)
+		if err != nil {
+			return newUsageError("неверное значение -has-open-reception %q, ожидается true или false", *f.hasOpenReception)
+		}
+
+		req.HasOpenReception = &hasOpen
+	}
+
+	var err error
+
+	if req.RegisteredFrom, err = parseDate(*f.registeredFrom); err != nil {
+		return err
+	}
+
+	if req.RegisteredTo, err = parseDate(*f.registeredTo); err != nil {
+		return err
+	}
+
+	if *f.sort != "" {
+		field, ok := sortFields[*f.sort]
+		if !ok {
+			return newUsageError("неизвестное поле сортировки %q, допустимы: registrationDate, receptionCount, lastActivity", *f.sort)
+		}
+
+		req.SortField = field
+	}
+
+	switch *f.order {
+	case "asc":
+		req.Ascending = true
+	case "desc":
+	default:
+		return newUsageError("неизвестное направление сортировки %q, допустимы: asc, desc", *f.order)
+	}
+
+	return nil
+}
+
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	fs.SetOutput(io.Discard)
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return newUsageError("%s", flagUsage(fs))
+		}
+
+		return newUsageError("%s: %s\n%s", fs.Name(), err, flagUsage(fs))
+	}
+
+	return nil
+}
+
+func flagUsage(fs *flag.FlagSet) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Флаги команды %s:\n", fs.Name())
+	fs.SetOutput(&b)
+	fs.PrintDefaults()
+	fs.SetOutput(io.Discard)
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func requireFlag(fs *flag.FlagSet, name, value string) error {
+	if value == "" {
+		return newUsageError("%s: флаг -%s обязателен\n%s", fs.Name(), name, flagUsage(fs))
+	}
+
+	return nil
+}
+
+func parseDate(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, newUsageError("неверная дата %q, ожидается RFC3339, например 2025-01-02T15:04:05Z", value)
+	}
+
+	return timestamppb.New(t), nil
+}
+
+func parseProductType(value string) (pbpvz.ProductType, error) {
+	productType, ok := productTypes[strings.ToLower(value)]
+	if !ok {
+		return pbpvz.ProductType_PRODUCT_TYPE_UNSPECIFIED,
+			newUsageError("неизвестный тип товара %q, допустимы: electronics, clothes, shoes", value)
+	}
+
+	return productType, nil
+}
+
+func runList(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	city := fs.String("city", "", "фильтр по городу")
+	startDate := fs.String("start", "", "начало диапазона дат приемок (RFC3339)")
+	endDate := fs.String("end", "", "конец диапазона дат приемок (RFC3339)")
+	page := fs.Int("page", 0, "номер страницы, 0 - значение по умолчанию")
+	limit := fs.Int("limit", 0, "размер страницы, 0 - значение по умолчанию")
+	pageToken := fs.String("page-token", "", "курсор следующей страницы из предыдущего ответа")
+	withTotal := fs.Bool("total", false, "посчитать общее количество ПВЗ")
+	include := fs.String("include", "", "вложенные данные через запятую: receptions, products; пусто - все")
+	receptionsLimit := fs.Int("receptions-limit", 0, "максимум приемок на ПВЗ, 0 - значение по умолчанию")
+	productsLimit := fs.Int("products-limit", 0, "максимум товаров на приемку, 0 - значение по умолчанию")
+	filters := addListFilterFlags(fs)
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	req := &pbpvz.GetPVZListRequest{
+		City:      *city,
+		PageToken: *pageToken,
+		WithTotal: *withTotal,
+		Include:   splitList(*include),
+		//nolint:gosec // сервер сам валидирует диапазон page и limit
+		Page: int32(*page),
+		//nolint:gosec // сервер сам валидирует диапазон page и limit
+		Limit: int32(*limit),
+		//nolint:gosec // сервер сам валидирует лимиты вложенных данных
+		ReceptionsLimit: int32(*receptionsLimit),
+		//nolint:gosec // сервер сам валидирует лимиты вложенных данных
+		ProductsLimit: int32(*productsLimit),
+	}
+
+	var err error
+
+	if req.StartDate, err = parseDate(*startDate); err != nil {
+		return err
+	}
+
+	if req.EndDate, err = parseDate(*endDate); err != nil {
+		return err
+	}
+
+	if err := filters.apply(req); err != nil {
+		return err
+	}
+
+	resp, err := client.GetPVZList(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if err := p.pvzs(resp, resp.GetPvzs()); err != nil {
+		return err
+	}
+
+	return p.pageInfo(resp)
+}
+
+func runGet(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	id := fs.String("id", "", "ID ПВЗ")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := requireFlag(fs, "id", *id); err != nil {
+		return err
+	}
+
+	resp, err := client.GetPVZ(ctx, &pbpvz.GetPVZRequest{Id: *id})
+	if err != nil {
+		return err
+	}
+
+	return p.pvzs(resp, []*pbpvz.PVZ{resp.GetPvz()})
+}
+
+func runBatch(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	fs := flag.NewFlagSet("batch", flag.ContinueOnError)
+	ids := fs.String("ids", "", "ID ПВЗ через запятую")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := requireFlag(fs, "ids", *ids); err != nil {
+		return err
+	}
+
+	resp, err := client.BatchGetPVZ(ctx, &pbpvz.BatchGetPVZRequest{Ids: splitList(*ids)})
+	if err != nil {
+		return err
+	}
+
+	if err := p.pvzs(resp, resp.GetPvzs()); err != nil {
+		return err
+	}
+
+	return p.notFound(resp.GetNotFoundIds())
+}
+
+func runCreatePVZ(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	fs := flag.NewFlagSet("create-pvz", flag.ContinueOnError)
+	city := fs.String("city", "", "город ПВЗ")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := requireFlag(fs, "city", *city); err != nil {
+		return err
+	}
+
+	resp, err := client.CreatePVZ(ctx, &pbpvz.CreatePVZRequest{City: *city})
+	if err != nil {
+		return err
+	}
+
+	return p.pvzs(resp, []*pbpvz.PVZ{resp.GetPvz()})
+}
+
+func runCreateReception(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	pvzID, err := parsePVZFlag("create-reception", args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CreateReception(ctx, &pbpvz.CreateReceptionRequest{PvzId: pvzID})
+	if err != nil {
+		return err
+	}
+
+	return p.reception(resp, resp.GetReception())
+}
+
+func runCloseReception(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	pvzID, err := parsePVZFlag("close-reception", args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CloseLastReception(ctx, &pbpvz.CloseLastReceptionRequest{PvzId: pvzID})
+	if err != nil {
+		return err
+	}
+
+	return p.reception(resp, resp.GetReception())
+}
+
+func runListReceptions(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	fs := flag.NewFlagSet("receptions", flag.ContinueOnError)
+	pvzID := fs.String("pvz", "", "ID ПВЗ")
+	limit := fs.Int("limit", 0, "размер страницы, 0 - значение по умолчанию")
+	pageToken := fs.String("page-token", "", "курсор следующей страницы из предыдущего ответа")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := requireFlag(fs, "pvz", *pvzID); err != nil {
+		return err
+	}
+
+	resp, err := client.ListReceptions(ctx, &pbpvz.ListReceptionsRequest{
+		PvzId:     *pvzID,
+		PageToken: *pageToken,
+		//nolint:gosec // сервер сам валидирует диапазон limit
+		Limit: int32(*limit),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := p.receptions(resp, resp.GetReceptions()); err != nil {
+		return err
+	}
+
+	return p.nextPage(resp.GetNextPageToken())
+}
+
+func runListProducts(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	fs := flag.NewFlagSet("products", flag.ContinueOnError)
+	receptionID := fs.String("reception", "", "ID приемки")
+	limit := fs.Int("limit", 0, "размер страницы, 0 - значение по умолчанию")
+	pageToken := fs.String("page-token", "", "курсор следующей страницы из предыдущего ответа")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := requireFlag(fs, "reception", *receptionID); err != nil {
+		return err
+	}
+
+	resp, err := client.ListProducts(ctx, &pbpvz.ListProductsRequest{
+		ReceptionId: *receptionID,
+		PageToken:   *pageToken,
+		//nolint:gosec // сервер сам валидирует диапазон limit
+		Limit: int32(*limit),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := p.products(resp, resp.GetProducts()); err != nil {
+		return err
+	}
+
+	return p.nextPage(resp.GetNextPageToken())
+}
+
+func runAddProduct(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	fs := flag.NewFlagSet("add-product", flag.ContinueOnError)
+	pvzID := fs.String("pvz", "", "ID ПВЗ")
+	typeName := fs.String("type", "", "тип товара: electronics, clothes или shoes")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := requireFlag(fs, "pvz", *pvzID); err != nil {
+		return err
+	}
+
+	productType, err := parseProductType(*typeName)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AddProduct(ctx, &pbpvz.AddProductRequest{PvzId: *pvzID, Type: productType})
+	if err != nil {
+		return err
+	}
+
+	return p.product(resp, resp.GetProduct())
+}
+
+func runDeleteProduct(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	pvzID, err := parsePVZFlag("delete-product", args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteLastProduct(ctx, &pbpvz.DeleteLastProductRequest{PvzId: pvzID})
+	if err != nil {
+		return err
+	}
+
+	return p.message(resp, "Последний товар удален")
+}
+
+func runScan(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	fs := flag.NewFlagSet("scan", flag.ContinueOnError)
+	pvzID := fs.String("pvz", "", "ID ПВЗ")
+	items := fs.String("items", "", "команды через запятую: тип товара или undo, например shoes,shoes,undo,clothes")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := requireFlag(fs, "pvz", *pvzID); err != nil {
+		return err
+	}
+
+	requests := []*pbpvz.ScanProductsRequest{{
+		Command: &pbpvz.ScanProductsRequest_Start{Start: &pbpvz.StartScan{PvzId: *pvzID}},
+	}}
+
+	for _, item := range splitList(*items) {
+		if item == undoCommand {
+			requests = append(requests, &pbpvz.ScanProductsRequest{
+				Command: &pbpvz.ScanProductsRequest_Undo{Undo: &pbpvz.UndoLastScan{}},
+			})
+
+			continue
+		}
+
+		productType, err := parseProductType(item)
+		if err != nil {
+			return err
+		}
+
+		requests = append(requests, &pbpvz.ScanProductsRequest{
+			Command: &pbpvz.ScanProductsRequest_Scan{Scan: &pbpvz.ProductScan{Type: productType}},
+		})
+	}
+
+	stream, err := client.ScanProducts(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Команды отправляются по одной с ожиданием ack, чтобы ответы шли в порядке команд.
+	for _, req := range requests {
+		if err := stream.Send(req); err != nil {
+			return streamError(stream.RecvMsg(&pbpvz.ScanProductsResponse{}), err)
+		}
+
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		if err := p.scanAck(resp); err != nil {
+			return err
+		}
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+
+	if _, err := stream.Recv(); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	return p.flush()
+}
+
+func runWatch(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ContinueOnError)
+	pvzIDs := fs.String("pvz", "", "ID ПВЗ через запятую")
+	city := fs.String("city", "", "фильтр по городу")
+	after := fs.Uint64("after", 0, "номер последнего полученного события; 0 - только новые события")
+	count := fs.Int("count", 0, "завершиться после N событий; 0 - без ограничения")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	stream, err := client.WatchPVZEvents(ctx, &pbpvz.WatchPVZEventsRequest{
+		PvzIds:        splitList(*pvzIDs),
+		City:          *city,
+		AfterSequence: *after,
+	})
+	if err != nil {
+		return err
+	}
+
+	for received := 0; *count == 0 || received < *count; received++ {
+		e, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return err
+		}
+
+		if err := p.event(e); err != nil {
+			return err
+		}
+	}
+
+	return p.flush()
+}
+
+func parsePVZFlag(name string, args []string) (string, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	pvzID := fs.String("pvz", "", "ID ПВЗ")
+
+	if err := parseFlags(fs, args); err != nil {
+		return "", err
+	}
+
+	if err := requireFlag(fs, "pvz", *pvzID); err != nil {
+		return "", err
+	}
+
+	return *pvzID, nil
+}
+
+// streamError возвращает статус, с которым сервер закрыл стрим, вместо io.EOF при отправке.
+func streamError(recvErr, sendErr error) error {
+	if recvErr != nil && !errors.Is(recvErr, io.EOF) {
+		return recvErr
+	}
+
+	return sendErr
+}
+
+// describeError добавляет к сообщению код gRPC и причину из ErrorInfo, если они есть.
+func describeError(err error) string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err.Error()
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return fmt.Sprintf("%s (%s): %s", st.Code(), info.GetReason(), st.Message())
+		}
+	}
+
+	return fmt.Sprintf("%s: %s", st.Code(), st.Message())
+}
@@ -0,0 +1,216 @@
+// Команда grpctest - консольный клиент PVZService для ручных и скриптовых проверок запущенного сервиса.
+//
+// Использование:
+//
+//	grpctest [общие флаги] <команда> [флаги команды]
+//
+// Токен передается флагом -token или получается через HTTP API:
+// по роли (-role, POST /dummyLogin) или по учетным данным (-email и -password, POST /login).
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	pbpvz "avito/internal/interfaces/grpc/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+type globalOptions struct {
+	grpcAddr string
+	httpAddr string
+	token    string
+	role     string
+	email    string
+	password string
+	output   string
+	timeout  time.Duration
+}
+
+type commandFunc func(ctx context.Context, client pbpvz.PVZServiceClient, p *printer, args []string) error
+
+type command struct {
+	description string
+	run         commandFunc
+	// streaming отключает общий таймаут: стрим живет, пока его не прервут или не закончатся данные.
+	streaming bool
+}
+
+var commands = map[string]command{
+	"list":             {description: "список ПВЗ с приемками и товарами (GetPVZList)", run: runList},
+	"get":              {description: "ПВЗ по ID (GetPVZ)", run: runGet},
+	"batch":            {description: "несколько ПВЗ по списку ID (BatchGetPVZ)", run: runBatch},
+	"create-pvz":       {description: "создать ПВЗ (CreatePVZ)", run: runCreatePVZ},
+	"create-reception": {description: "создать приемку (CreateReception)", run: runCreateReception},
+	"close-reception":  {description: "закрыть последнюю приемку (CloseLastReception)", run: runCloseReception},
+	"receptions":       {description: "приемки ПВЗ постранично (ListReceptions)", run: runListReceptions},
+	"products":         {description: "товары приемки постранично (ListProducts)", run: runListProducts},
+	"add-product":      {description: "добавить товар (AddProduct)", run: runAddProduct},
+	"delete-product":   {description: "удалить последний товар (DeleteLastProduct)", run: runDeleteProduct},
+	"scan":             {description: "массовое сканирование товаров (ScanProducts)", run: runScan, streaming: true},
+	"watch":            {description: "поток событий приемок (WatchPVZEvents)", run: runWatch, streaming: true},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	opts := globalOptions{}
+
+	fs := flag.NewFlagSet("grpctest", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&opts.grpcAddr, "addr", "localhost:3000", "адрес gRPC-сервера")
+	fs.StringVar(&opts.httpAddr, "http", "http://localhost:8080", "адрес HTTP API для получения токена")
+	fs.StringVar(&opts.token, "token", "", "JWT-токен; если не задан, токен запрашивается через HTTP API")
+	fs.StringVar(&opts.role, "role", "employee", "роль для /dummyLogin: employee или moderator")
+	fs.StringVar(&opts.email, "email", "", "email для /login вместо /dummyLogin")
+	fs.StringVar(&opts.password, "password", "", "пароль для /login")
+	fs.StringVar(&opts.output, "output", outputTable, "формат вывода: table или json")
+	fs.DurationVar(&opts.timeout, "timeout", 10*time.Second, "таймаут унарных вызовов и авторизации")
+	fs.Usage = func() { printUsage(fs) }
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsage
+	}
+
+	if opts.output != outputTable && opts.output != outputJSON {
+		fmt.Fprintf(stderr, "неизвестный формат вывода %q\n", opts.output)
+		return exitUsage
+	}
+
+	name := "list"
+	if fs.NArg() > 0 {
+		name = fs.Arg(0)
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "неизвестная команда %q\n\n", name)
+		printUsage(fs)
+
+		return exitUsage
+	}
+
+	if err := execute(opts, cmd, fs.Args(), stdout); err != nil {
+		var usageErr *usageError
+		if errors.As(err, &usageErr) {
+			fmt.Fprintln(stderr, err)
+			return exitUsage
+		}
+
+		fmt.Fprintf(stderr, "ошибка: %s\n", describeError(err))
+
+		return exitError
+	}
+
+	return exitOK
+}
+
+func execute(opts globalOptions, cmd command, args []string, stdout io.Writer) error {
+	var cmdArgs []string
+	if len(args) > 1 {
+		cmdArgs = args[1:]
+	}
+
+	token := opts.token
+	if token == "" {
+		loginCtx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+		defer cancel()
+
+		var err error
+
+		token, err = login(loginCtx, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := grpc.NewClient(opts.grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("ошибка при подключении к gRPC-серверу: %w", err)
+	}
+	defer conn.Close()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	if !cmd.streaming {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, opts.timeout)
+		defer cancel()
+	}
+
+	return cmd.run(ctx, pbpvz.NewPVZServiceClient(conn), newPrinter(opts.output, stdout), cmdArgs)
+}
+
+func printUsage(fs *flag.FlagSet) {
+	out := fs.Output()
+
+	fmt.Fprintln(out, "Использование: grpctest [общие флаги] <команда> [флаги команды]")
+	fmt.Fprintln(out, "\nКоманды (по умолчанию list):")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(out, "  %-17s %s\n", name, commands[name].description)
+	}
+
+	fmt.Fprintln(out, "\nФлаги команды: grpctest <команда> -h")
+	fmt.Fprintln(out, "\nОбщие флаги:")
+	fs.PrintDefaults()
+}
+
+type usageError struct {
+	message string
+}
+
+func (e *usageError) Error() string {
+	return e.message
+}
+
+func newUsageError(format string, args ...any) error {
+	return &usageError{message: fmt.Sprintf(format, args...)}
+}
+
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	parts := strings.Split(value, ",")
+	result := make([]string, 0, len(parts))
+
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pbpvz "avito/internal/interfaces/grpc/pb"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// printer выводит ответы таблицей или JSON.
+// Унарные ответы в JSON печатаются целиком, события стримов - по одному JSON-объекту в строке.
+type printer struct {
+	format        string
+	out           io.Writer
+	streamStarted bool
+}
+
+func newPrinter(format string, out io.Writer) *printer {
+	return &printer{
+		format: format,
+		out:    out,
+	}
+}
+
+func (p *printer) json(msg proto.Message, multiline bool) error {
+	opts := protojson.MarshalOptions{EmitUnpopulated: true}
+	if multiline {
+		opts.Multiline = true
+		opts.Indent = "  "
+	}
+
+	data, err := opts.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(p.out, string(data))
+
+	return err
+}
+
+func (p *printer) pvzs(resp proto.Message, items []*pbpvz.PVZ) error {
+	if p.format == outputJSON {
+		return p.json(resp, true)
+	}
+
+	w := tabwriter.NewWriter(p.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tГОРОД\tРЕГИСТРАЦИЯ\tПРИЕМОК\tОТКРЫТАЯ ПРИЕМКА\tТОВАРОВ")
+
+	for _, item := range items {
+		openReception := "-"
+		products := 0
+
+		for _, rec := range item.GetReceptions() {
+			products += len(rec.GetProducts())
+
+			if rec.GetReception().GetStatus() == pbpvz.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS {
+				openReception = rec.GetReception().GetId()
+			}
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%d\n",
+			item.GetId(), item.GetCity(), formatTime(item.GetRegistrationDate()),
+			len(item.GetReceptions()), openReception, products)
+	}
+
+	return w.Flush()
+}
+
+// pageInfo выводит общее количество и курсор следующей страницы. В JSON они уже есть в самом ответе.
+func (p *printer) pageInfo(resp *pbpvz.GetPVZListResponse) error {
+	if p.format == outputJSON {
+		return nil
+	}
+
+	if resp.TotalCount != nil {
+		if _, err := fmt.Fprintf(p.out, "\nВсего ПВЗ: %d\n", resp.GetTotalCount()); err != nil {
+			return err
+		}
+	}
+
+	return p.nextPage(resp.GetNextPageToken())
+}
+
+// nextPage выводит курсор следующей страницы, если она есть.
+func (p *printer) nextPage(token string) error {
+	if p.format == outputJSON || token == "" {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(p.out, "Следующая страница: -page-token %s\n", token)
+
+	return err
+}
+
+func (p *printer) notFound(ids []string) error {
+	if p.format == outputJSON || len(ids) == 0 {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(p.out, "\nНе найдены: %s\n", strings.Join(ids, ", "))
+
+	return err
+}
+
+func (p *printer) reception(resp proto.Message, rec *pbpvz.Reception) error {
+	return p.receptions(resp, []*pbpvz.Reception{rec})
+}
+
+func (p *printer) receptions(resp proto.Message, items []*pbpvz.Reception) error {
+	if p.format == outputJSON {
+		return p.json(resp, true)
+	}
+
+	w := tabwriter.NewWriter(p.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tПВЗ\tСТАТУС\tДАТА")
+
+	for _, rec := range items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			rec.GetId(), rec.GetPvzId(), receptionStatusName(rec.GetStatus()), formatTime(rec.GetDateTime()))
+	}
+
+	return w.Flush()
+}
+
+func (p *printer) product(resp proto.Message, product *pbpvz.Product) error {
+	return p.products(resp, []*pbpvz.Product{product})
+}
+
+func (p *printer) products(resp proto.Message, items []*pbpvz.Product) error {
+	if p.format == outputJSON {
+		return p.json(resp, true)
+	}
+
+	w := tabwriter.NewWriter(p.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tТИП\tПРИЕМКА\tДАТА")
+
+	for _, product := range items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			product.GetId(), productTypeName(product.GetType()), product.GetReceptionId(), formatTime(product.GetDateTime()))
+	}
+
+	return w.Flush()
+}
+
+func (p *printer) message(resp proto.Message, text string) error {
+	if p.format == outputJSON {
+		return p.json(resp, true)
+	}
+
+	_, err := fmt.Fprintln(p.out, text)
+
+	return err
+}
+
+func (p *printer) scanAck(resp *pbpvz.ScanProductsResponse) error {
+	if p.format == outputJSON {
+		return p.json(resp, false)
+	}
+
+	p.streamHeader("%-8s %-5s %-36s %-12s %s\n", "ДЕЙСТВИЕ", "НОМЕР", "ТОВАР", "ТИП", "ОШИБКА")
+
+	errText := ""
+	if resp.GetError() != nil {
+		errText = resp.GetError().GetReason() + ": " + resp.GetError().GetMessage()
+	}
+
+	id, productType := "-", "-"
+	if resp.GetProduct() != nil {
+		id, productType = resp.GetProduct().GetId(), productTypeName(resp.GetProduct().GetType())
+	} else if resp.GetReception() != nil {
+		id = resp.GetReception().GetId()
+	}
+
+	_, err := fmt.Fprintf(p.out, "%-8s %-5d %-36s %-12s %s\n",
+		scanActionName(resp.GetAction()), resp.GetSequenceNumber(), id, productType, errText)
+
+	return err
+}
+
+func (p *printer) event(e *pbpvz.PVZEvent) error {
+	if p.format == outputJSON {
+		return p.json(e, false)
+	}
+
+	p.streamHeader("%-6s %-18s %-20s %-36s %-36s %s\n", "НОМЕР", "СОБЫТИЕ", "ВРЕМЯ", "ПВЗ", "ПРИЕМКА", "ТОВАР")
+
+	product := "-"
+	if e.GetProduct() != nil {
+		product = e.GetProduct().GetId() + " " + productTypeName(e.GetProduct().GetType())
+	}
+
+	_, err := fmt.Fprintf(p.out, "%-6d %-18s %-20s %-36s %-36s %s\n",
+		e.GetSequence(), eventTypeName(e.GetType()), formatTime(e.GetOccurredAt()), e.GetPvzId(), e.GetReceptionId(), product)
+
+	return err
+}
+
+// streamHeader печатает заголовок таблицы стрима один раз перед первой строкой.
+func (p *printer) streamHeader(format string, columns ...any) {
+	if p.streamStarted {
+		return
+	}
+
+	p.streamStarted = true
+	fmt.Fprintf(p.out, format, columns...)
+}
+
+// flush завершает вывод стрима; если событий не было, в табличном режиме сообщает об этом.
+func (p *printer) flush() error {
+	if p.format == outputTable && !p.streamStarted {
+		_, err := fmt.Fprintln(p.out, "Нет данных")
+		return err
+	}
+
+	return nil
+}
+
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+
+	return ts.AsTime().Local().Format(time.DateTime)
+}
+
+func receptionStatusName(s pbpvz.ReceptionStatus) string {
+	if s == pbpvz.ReceptionStatus_RECEPTION_STATUS_CLOSED {
+		return "close"
+	}
+
+	return "in_progress"
+}
+
+func productTypeName(t pbpvz.ProductType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "PRODUCT_TYPE_"))
+}
+
+func eventTypeName(t pbpvz.PVZEventType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "PVZ_EVENT_TYPE_"))
+}
+
+func scanActionName(a pbpvz.ScanAction) string {
+	return strings.ToLower(strings.TrimPrefix(a.String(), "SCAN_ACTION_"))
+}
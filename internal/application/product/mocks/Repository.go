@@ -47,21 +47,33 @@ func (_m *Repository) AddProduct(ctx context.Context, productType product.Type,
 }
 
 // DeleteLastProduct provides a mock function with given fields: ctx, receptionID
-func (_m *Repository) DeleteLastProduct(ctx context.Context, receptionID uuid.UUID) error {
+func (_m *Repository) DeleteLastProduct(ctx context.Context, receptionID uuid.UUID) (*product.Product, error) {
 	ret := _m.Called(ctx, receptionID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteLastProduct")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+	var r0 *product.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*product.Product, error)); ok {
+		return rf(ctx, receptionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *product.Product); ok {
 		r0 = rf(ctx, receptionID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*product.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, receptionID)
+	} else {
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
 // GetProductsByReceptionID provides a mock function with given fields: ctx, receptionID
@@ -94,6 +106,36 @@ func (_m *Repository) GetProductsByReceptionID(ctx context.Context, receptionID
 	return r0, r1
 }
 
+// ListProductsByReceptionID provides a mock function with given fields: ctx, req
+func (_m *Repository) ListProductsByReceptionID(ctx context.Context, req product.ListRequest) (*product.Page, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProductsByReceptionID")
+	}
+
+	var r0 *product.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, product.ListRequest) (*product.Page, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, product.ListRequest) *product.Page); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*product.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, product.ListRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRepository(t interface {
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"avito/internal/domain/event"
 	"avito/internal/domain/product"
 	domainPVZ "avito/internal/domain/pvz"
 	"avito/internal/domain/reception"
+	"avito/pkg/txs"
 
 	"github.com/google/uuid"
 )
@@ -17,8 +19,9 @@ type Transactor interface {
 
 type Repository interface {
 	AddProduct(ctx context.Context, productType product.Type, receptionID uuid.UUID) (*product.Product, error)
-	DeleteLastProduct(ctx context.Context, receptionID uuid.UUID) error
+	DeleteLastProduct(ctx context.Context, receptionID uuid.UUID) (*product.Product, error)
 	GetProductsByReceptionID(ctx context.Context, receptionID uuid.UUID) ([]product.Product, error)
+	ListProductsByReceptionID(ctx context.Context, req product.ListRequest) (*product.Page, error)
 }
 
 type ReceptionRepository interface {
@@ -30,37 +33,63 @@ type PVZRepository interface {
 	GetPVZByID(ctx context.Context, id uuid.UUID) (*domainPVZ.PVZ, error)
 }
 
+type EventPublisher interface {
+	Publish(ctx context.Context, e event.Event)
+}
+
 type Service struct {
 	repo          Repository
 	receptionRepo ReceptionRepository
 	pvzRepo       PVZRepository
 	txManager     Transactor
+	publisher     EventPublisher
 }
 
-func NewService(repo Repository, receptionRepo ReceptionRepository, pvzRepo PVZRepository, txManager Transactor) *Service {
+func NewService(repo Repository, receptionRepo ReceptionRepository, pvzRepo PVZRepository, txManager Transactor,
+	publisher EventPublisher) *Service {
 	return &Service{
 		repo:          repo,
 		receptionRepo: receptionRepo,
 		pvzRepo:       pvzRepo,
 		txManager:     txManager,
+		publisher:     publisher,
 	}
 }
 
 func (s *Service) AddProduct(ctx context.Context, req product.CreateProductRequest) (*product.Product, error) {
-	if req.Type == "" {
-		return nil, &product.ErrTypeEmpty{}
+	if err := validateProductType(req.Type); err != nil {
+		return nil, err
+	}
+
+	activeReception, err := s.OpenScanSession(ctx, req.PVZID)
+	if err != nil {
+		return nil, err
 	}
 
-	if !req.Type.Validate() {
-		return nil, &product.ErrInvalidProductType{}
+	return s.AddProductToReception(ctx, activeReception, req.Type)
+}
+
+func (s *Service) DeleteLastProduct(ctx context.Context, pvzID uuid.UUID) error {
+	activeReception, err := s.OpenScanSession(ctx, pvzID)
+	if err != nil {
+		return err
 	}
 
-	_, err := s.pvzRepo.GetPVZByID(ctx, req.PVZID)
+	_, err = s.DeleteLastProductFromReception(ctx, activeReception)
+
+	return err
+}
+
+// OpenScanSession проверяет ПВЗ и возвращает его активную приемку.
+// Результат используется для серии добавлений и удалений товаров без повторных проверок ПВЗ.
+func (s *Service) OpenScanSession(ctx context.Context, pvzID uuid.UUID) (*reception.Reception, error) {
+	// Проверки перед записью читают из primary, чтобы не отклонить только что созданный ПВЗ.
+	_, err := s.pvzRepo.GetPVZByID(txs.WithPrimary(ctx), pvzID)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при проверке ПВЗ: %w", err)
 	}
 
-	activeReception, err := s.receptionRepo.GetActiveReceptionByPVZID(ctx, req.PVZID)
+	activeReception, err := s.receptionRepo.GetActiveReceptionByPVZID(ctx, pvzID)
 	if err != nil {
 		return nil, err
 	}
@@ -69,21 +98,39 @@ func (s *Service) AddProduct(ctx context.Context, req product.CreateProductReque
 		return nil, &reception.ErrReceptionClosed{}
 	}
 
+	return activeReception, nil
+}
+
+// AddProductToReception добавляет товар в приемку, полученную из OpenScanSession.
+// Статус приемки перепроверяется в транзакции, так как ее могли закрыть после открытия сессии.
+func (s *Service) AddProductToReception(ctx context.Context, activeReception *reception.Reception,
+	productType product.Type) (*product.Product, error) {
+	if err := validateProductType(productType); err != nil {
+		return nil, err
+	}
+
 	var productObj *product.Product
 
-	err = s.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
-		currReception, err := s.receptionRepo.GetReceptionByID(txCtx, activeReception.ID)
-		if err != nil {
-			return fmt.Errorf("ошибка при проверке приемки: %w", err)
+	err := s.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.checkReceptionInProgress(txCtx, activeReception.ID); err != nil {
+			return err
 		}
 
-		if currReception.Status == reception.StatusClosed {
-			return &reception.ErrReceptionClosed{}
+		var err error
+		if productObj, err = s.repo.AddProduct(txCtx, productType, activeReception.ID); err != nil {
+			return err
 		}
 
-		productObj, err = s.repo.AddProduct(txCtx, req.Type, activeReception.ID)
+		txs.AfterCommit(txCtx, func() {
+			s.publisher.Publish(ctx, event.Event{
+				Type:        event.TypeProductAdded,
+				PVZID:       activeReception.PVZID,
+				ReceptionID: productObj.ReceptionID,
+				Product:     productObj,
+			})
+		})
 
-		return err
+		return nil
 	})
 
 	if err != nil {
@@ -93,39 +140,39 @@ func (s *Service) AddProduct(ctx context.Context, req product.CreateProductReque
 	return productObj, nil
 }
 
-func (s *Service) DeleteLastProduct(ctx context.Context, pvzID uuid.UUID) error {
-	_, err := s.pvzRepo.GetPVZByID(ctx, pvzID)
-	if err != nil {
-		return fmt.Errorf("ошибка при проверке ПВЗ: %w", err)
-	}
+// DeleteLastProductFromReception удаляет последний товар приемки, полученной из OpenScanSession,
+// и возвращает удаленный товар.
+func (s *Service) DeleteLastProductFromReception(ctx context.Context,
+	activeReception *reception.Reception) (*product.Product, error) {
+	var deletedProduct *product.Product
 
-	activeReception, err := s.receptionRepo.GetActiveReceptionByPVZID(ctx, pvzID)
-	if err != nil {
-		return err
-	}
-
-	if activeReception.Status == reception.StatusClosed {
-		return &reception.ErrReceptionClosed{}
-	}
-
-	err = s.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
-		currReception, err := s.receptionRepo.GetReceptionByID(txCtx, activeReception.ID)
-		if err != nil {
-			return fmt.Errorf("ошибка при проверке приемки: %w", err)
+	err := s.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.checkReceptionInProgress(txCtx, activeReception.ID); err != nil {
+			return err
 		}
 
-		if currReception.Status == reception.StatusClosed {
-			return &reception.ErrReceptionClosed{}
+		var err error
+		if deletedProduct, err = s.repo.DeleteLastProduct(txCtx, activeReception.ID); err != nil {
+			return err
 		}
 
-		return s.repo.DeleteLastProduct(txCtx, activeReception.ID)
+		txs.AfterCommit(txCtx, func() {
+			s.publisher.Publish(ctx, event.Event{
+				Type:        event.TypeProductDeleted,
+				PVZID:       activeReception.PVZID,
+				ReceptionID: activeReception.ID,
+				Product:     deletedProduct,
+			})
+		})
+
+		return nil
 	})
 
 	if err != nil {
-		return fmt.Errorf("ошибка при удалении товара: %w", err)
+		return nil, fmt.Errorf("ошибка при удалении товара: %w", err)
 	}
 
-	return nil
+	return deletedProduct, nil
 }
 
 func (s *Service) GetProductsByReceptionID(ctx context.Context, receptionID uuid.UUID) ([]product.Product, error) {
@@ -136,3 +183,45 @@ func (s *Service) GetProductsByReceptionID(ctx context.Context, receptionID uuid
 
 	return s.repo.GetProductsByReceptionID(ctx, receptionID)
 }
+
+// ListProducts возвращает страницу товаров приемки в порядке добавления.
+func (s *Service) ListProducts(ctx context.Context, req product.ListRequest) (*product.Page, error) {
+	if req.Limit < 0 || req.Limit > product.MaxPageSize {
+		return nil, &domainPVZ.ErrInvalidPaginationParams{}
+	}
+
+	if req.Limit == 0 {
+		req.Limit = product.DefaultPageSize
+	}
+
+	if _, err := s.receptionRepo.GetReceptionByID(ctx, req.ReceptionID); err != nil {
+		return nil, fmt.Errorf("ошибка при проверке приемки: %w", err)
+	}
+
+	return s.repo.ListProductsByReceptionID(ctx, req)
+}
+
+func (s *Service) checkReceptionInProgress(ctx context.Context, receptionID uuid.UUID) error {
+	currReception, err := s.receptionRepo.GetReceptionByID(ctx, receptionID)
+	if err != nil {
+		return fmt.Errorf("ошибка при проверке приемки: %w", err)
+	}
+
+	if currReception.Status == reception.StatusClosed {
+		return &reception.ErrReceptionClosed{}
+	}
+
+	return nil
+}
+
+func validateProductType(productType product.Type) error {
+	if productType == "" {
+		return &product.ErrTypeEmpty{}
+	}
+
+	if !productType.Validate() {
+		return &product.ErrInvalidProductType{}
+	}
+
+	return nil
+}
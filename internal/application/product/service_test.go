@@ -9,6 +9,8 @@ import (
 
 	"avito/internal/application/product"
 	"avito/internal/application/product/mocks"
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/event"
 	domainProduct "avito/internal/domain/product"
 	domainPVZ "avito/internal/domain/pvz"
 	domainReception "avito/internal/domain/reception"
@@ -161,12 +163,19 @@ func TestService_AddProduct(t *testing.T) {
 			mockReceptionRepo := new(mocks.ReceptionRepository)
 			mockPVZRepo := new(mocks.PVZRepository)
 			mockTx := new(mocks.Transactor)
+			mockPublisher := new(mocks.EventPublisher)
+
+			if tt.expectedErrorText == "" {
+				mockPublisher.On("Publish", mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+					return e.Type == event.TypeProductAdded
+				})).Once()
+			}
 
 			if tt.mockSetup != nil {
 				tt.mockSetup(mockRepo, mockReceptionRepo, mockPVZRepo, mockTx)
 			}
 
-			service := product.NewService(mockRepo, mockReceptionRepo, mockPVZRepo, mockTx)
+			service := product.NewService(mockRepo, mockReceptionRepo, mockPVZRepo, mockTx, mockPublisher)
 
 			result, err := service.AddProduct(context.Background(), tt.request)
 
@@ -187,6 +196,7 @@ func TestService_AddProduct(t *testing.T) {
 			mockReceptionRepo.AssertExpectations(t)
 			mockPVZRepo.AssertExpectations(t)
 			mockTx.AssertExpectations(t)
+			mockPublisher.AssertExpectations(t)
 		})
 	}
 }
@@ -221,7 +231,14 @@ func TestService_DeleteLastProduct(t *testing.T) {
 				receptionRepo.On("GetActiveReceptionByPVZID", mock.Anything, pvzID).Return(reception, nil)
 				receptionRepo.On("GetReceptionByID", mock.Anything, receptionID).Return(reception, nil)
 
-				repo.On("DeleteLastProduct", mock.Anything, receptionID).Return(nil)
+				deletedProduct := &domainProduct.Product{
+					ID:             uuid.New(),
+					DateTime:       time.Now(),
+					Type:           domainProduct.TypeElectronics,
+					ReceptionID:    receptionID,
+					SequenceNumber: 1,
+				}
+				repo.On("DeleteLastProduct", mock.Anything, receptionID).Return(deletedProduct, nil)
 
 				tx.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).Return(nil).
 					Run(func(args mock.Arguments) {
@@ -275,7 +292,7 @@ func TestService_DeleteLastProduct(t *testing.T) {
 				receptionRepo.On("GetActiveReceptionByPVZID", mock.Anything, pvzID).Return(reception, nil)
 				receptionRepo.On("GetReceptionByID", mock.Anything, receptionID).Return(reception, nil)
 
-				repo.On("DeleteLastProduct", mock.Anything, receptionID).Return(&domainProduct.ErrNoProductsToDelete{})
+				repo.On("DeleteLastProduct", mock.Anything, receptionID).Return(nil, &domainProduct.ErrNoProductsToDelete{})
 
 				tx.On("WithTransaction", mock.Anything,
 					mock.AnythingOfType("func(context.Context) error")).Return(&domainProduct.ErrNoProductsToDelete{}).
@@ -295,12 +312,19 @@ func TestService_DeleteLastProduct(t *testing.T) {
 			mockReceptionRepo := new(mocks.ReceptionRepository)
 			mockPVZRepo := new(mocks.PVZRepository)
 			mockTx := new(mocks.Transactor)
+			mockPublisher := new(mocks.EventPublisher)
+
+			if tt.expectedErrorText == "" {
+				mockPublisher.On("Publish", mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+					return e.Type == event.TypeProductDeleted
+				})).Once()
+			}
 
 			if tt.mockSetup != nil {
 				tt.mockSetup(mockRepo, mockReceptionRepo, mockPVZRepo, mockTx)
 			}
 
-			service := product.NewService(mockRepo, mockReceptionRepo, mockPVZRepo, mockTx)
+			service := product.NewService(mockRepo, mockReceptionRepo, mockPVZRepo, mockTx, mockPublisher)
 
 			err := service.DeleteLastProduct(context.Background(), tt.pvzID)
 
@@ -317,6 +341,7 @@ func TestService_DeleteLastProduct(t *testing.T) {
 			mockReceptionRepo.AssertExpectations(t)
 			mockPVZRepo.AssertExpectations(t)
 			mockTx.AssertExpectations(t)
+			mockPublisher.AssertExpectations(t)
 		})
 	}
 }
@@ -406,12 +431,13 @@ func TestService_GetProductsByReceptionID(t *testing.T) {
 			mockReceptionRepo := new(mocks.ReceptionRepository)
 			mockPVZRepo := new(mocks.PVZRepository)
 			mockTx := new(mocks.Transactor)
+			mockPublisher := new(mocks.EventPublisher)
 
 			if tt.mockSetup != nil {
 				tt.mockSetup(mockRepo, mockReceptionRepo)
 			}
 
-			service := product.NewService(mockRepo, mockReceptionRepo, mockPVZRepo, mockTx)
+			service := product.NewService(mockRepo, mockReceptionRepo, mockPVZRepo, mockTx, mockPublisher)
 
 			products, err := service.GetProductsByReceptionID(context.Background(), tt.receptionID)
 
@@ -438,3 +464,226 @@ func TestService_GetProductsByReceptionID(t *testing.T) {
 		})
 	}
 }
+
+func TestService_AddProductToReception(t *testing.T) {
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+
+	activeReception := &domainReception.Reception{
+		ID:       receptionID,
+		DateTime: time.Now(),
+		PVZID:    pvzID,
+		Status:   domainReception.StatusInProgress,
+	}
+
+	tests := []struct {
+		name              string
+		productType       domainProduct.Type
+		mockSetup         func(*mocks.Repository, *mocks.ReceptionRepository, *mocks.Transactor, *mocks.EventPublisher)
+		expectedSequence  int
+		expectedErrorText string
+	}{
+		{
+			name:        "Успешное добавление без повторной проверки ПВЗ",
+			productType: domainProduct.TypeShoes,
+			mockSetup: func(repo *mocks.Repository, receptionRepo *mocks.ReceptionRepository, tx *mocks.Transactor,
+				publisher *mocks.EventPublisher) {
+				receptionRepo.On("GetReceptionByID", mock.Anything, receptionID).Return(activeReception, nil)
+
+				createdProduct := &domainProduct.Product{
+					ID:             uuid.New(),
+					DateTime:       time.Now(),
+					Type:           domainProduct.TypeShoes,
+					ReceptionID:    receptionID,
+					SequenceNumber: 7,
+				}
+				repo.On("AddProduct", mock.Anything, domainProduct.TypeShoes, receptionID).Return(createdProduct, nil)
+
+				tx.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+					Return(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+
+				publisher.On("Publish", mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+					return e.Type == event.TypeProductAdded && e.PVZID == pvzID
+				})).Once()
+			},
+			expectedSequence: 7,
+		},
+		{
+			name:        "Неверный тип товара",
+			productType: "неверный_тип",
+			mockSetup: func(repo *mocks.Repository, receptionRepo *mocks.ReceptionRepository, tx *mocks.Transactor,
+				publisher *mocks.EventPublisher) {
+				// Моки не должны вызываться
+			},
+			expectedErrorText: "неверный тип товара",
+		},
+		{
+			name:        "Приемку закрыли после открытия сессии",
+			productType: domainProduct.TypeShoes,
+			mockSetup: func(repo *mocks.Repository, receptionRepo *mocks.ReceptionRepository, tx *mocks.Transactor,
+				publisher *mocks.EventPublisher) {
+				closedReception := *activeReception
+				closedReception.Status = domainReception.StatusClosed
+				receptionRepo.On("GetReceptionByID", mock.Anything, receptionID).Return(&closedReception, nil)
+
+				tx.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+					Return(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+			},
+			expectedErrorText: "приемка уже закрыта",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.Repository)
+			mockReceptionRepo := new(mocks.ReceptionRepository)
+			mockPVZRepo := new(mocks.PVZRepository)
+			mockTx := new(mocks.Transactor)
+			mockPublisher := new(mocks.EventPublisher)
+
+			tt.mockSetup(mockRepo, mockReceptionRepo, mockTx, mockPublisher)
+
+			service := product.NewService(mockRepo, mockReceptionRepo, mockPVZRepo, mockTx, mockPublisher)
+
+			result, err := service.AddProductToReception(context.Background(), activeReception, tt.productType)
+
+			if tt.expectedErrorText != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorText)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedSequence, result.SequenceNumber)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockReceptionRepo.AssertExpectations(t)
+			mockPVZRepo.AssertExpectations(t)
+			mockTx.AssertExpectations(t)
+			mockPublisher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_DeleteLastProductFromReception(t *testing.T) {
+	receptionID := uuid.New()
+
+	activeReception := &domainReception.Reception{
+		ID:       receptionID,
+		DateTime: time.Now(),
+		PVZID:    uuid.New(),
+		Status:   domainReception.StatusInProgress,
+	}
+
+	deletedProduct := &domainProduct.Product{
+		ID:             uuid.New(),
+		DateTime:       time.Now(),
+		Type:           domainProduct.TypeClothes,
+		ReceptionID:    receptionID,
+		SequenceNumber: 3,
+	}
+
+	mockRepo := new(mocks.Repository)
+	mockReceptionRepo := new(mocks.ReceptionRepository)
+	mockPVZRepo := new(mocks.PVZRepository)
+	mockTx := new(mocks.Transactor)
+	mockPublisher := new(mocks.EventPublisher)
+
+	mockReceptionRepo.On("GetReceptionByID", mock.Anything, receptionID).Return(activeReception, nil)
+	mockRepo.On("DeleteLastProduct", mock.Anything, receptionID).Return(deletedProduct, nil)
+	mockTx.On("WithTransaction", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+	mockPublisher.On("Publish", mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+		return e.Type == event.TypeProductDeleted && e.Product == deletedProduct
+	})).Once()
+
+	service := product.NewService(mockRepo, mockReceptionRepo, mockPVZRepo, mockTx, mockPublisher)
+
+	result, err := service.DeleteLastProductFromReception(context.Background(), activeReception)
+
+	assert.NoError(t, err)
+	assert.Equal(t, deletedProduct, result)
+
+	mockRepo.AssertExpectations(t)
+	mockReceptionRepo.AssertExpectations(t)
+	mockPVZRepo.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestService_ListProducts(t *testing.T) {
+	receptionID := uuid.New()
+
+	tests := []struct {
+		name          string
+		request       domainProduct.ListRequest
+		mockSetup     func(*mocks.Repository, *mocks.ReceptionRepository)
+		expectedError error
+	}{
+		{
+			name:    "Первая страница с лимитом по умолчанию",
+			request: domainProduct.ListRequest{ReceptionID: receptionID},
+			mockSetup: func(repo *mocks.Repository, receptionRepo *mocks.ReceptionRepository) {
+				receptionRepo.On("GetReceptionByID", mock.Anything, receptionID).
+					Return(&domainReception.Reception{ID: receptionID}, nil)
+				repo.On("ListProductsByReceptionID", mock.Anything, domainProduct.ListRequest{
+					ReceptionID: receptionID,
+					Limit:       domainProduct.DefaultPageSize,
+				}).Return(&domainProduct.Page{}, nil)
+			},
+		},
+		{
+			name:    "Страница по курсору",
+			request: domainProduct.ListRequest{ReceptionID: receptionID, After: &domainProduct.Cursor{SequenceNumber: 3}, Limit: 2},
+			mockSetup: func(repo *mocks.Repository, receptionRepo *mocks.ReceptionRepository) {
+				receptionRepo.On("GetReceptionByID", mock.Anything, receptionID).
+					Return(&domainReception.Reception{ID: receptionID}, nil)
+				repo.On("ListProductsByReceptionID", mock.Anything, domainProduct.ListRequest{
+					ReceptionID: receptionID,
+					After:       &domainProduct.Cursor{SequenceNumber: 3},
+					Limit:       2,
+				}).Return(&domainProduct.Page{}, nil)
+			},
+		},
+		{
+			name:    "Приемка не найдена",
+			request: domainProduct.ListRequest{ReceptionID: receptionID},
+			mockSetup: func(repo *mocks.Repository, receptionRepo *mocks.ReceptionRepository) {
+				receptionRepo.On("GetReceptionByID", mock.Anything, receptionID).Return(nil, &domainReception.ErrReceptionNotFound{})
+			},
+			expectedError: &domainReception.ErrReceptionNotFound{},
+		},
+		{
+			name:          "Отрицательный лимит",
+			request:       domainProduct.ListRequest{ReceptionID: receptionID, Limit: -1},
+			expectedError: &domainPVZ.ErrInvalidPaginationParams{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.Repository)
+			mockReceptionRepo := new(mocks.ReceptionRepository)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockRepo, mockReceptionRepo)
+			}
+
+			service := product.NewService(mockRepo, mockReceptionRepo, new(mocks.PVZRepository), new(mocks.Transactor),
+				new(mocks.EventPublisher))
+
+			page, err := service.ListProducts(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, errcode.Of(tt.expectedError), errcode.Of(err))
+				assert.Nil(t, page)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, page)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockReceptionRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -8,8 +8,6 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
-	time "time"
-
 	uuid "github.com/google/uuid"
 )
 
@@ -18,6 +16,34 @@ type Repository struct {
 	mock.Mock
 }
 
+// CountPVZs provides a mock function with given fields: ctx, req
+func (_m *Repository) CountPVZs(ctx context.Context, req pvz.GetPVZsRequest) (int, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPVZs")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pvz.GetPVZsRequest) (int, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pvz.GetPVZsRequest) int); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pvz.GetPVZsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreatePVZ provides a mock function with given fields: ctx, city
 func (_m *Repository) CreatePVZ(ctx context.Context, city pvz.City) (*pvz.PVZ, error) {
 	ret := _m.Called(ctx, city)
@@ -78,29 +104,59 @@ func (_m *Repository) GetPVZByID(ctx context.Context, id uuid.UUID) (*pvz.PVZ, e
 	return r0, r1
 }
 
-// GetPVZs provides a mock function with given fields: ctx, startDate, endDate, city, page, limit
-func (_m *Repository) GetPVZs(ctx context.Context, startDate *time.Time, endDate *time.Time, city *pvz.City, page int, limit int) ([]pvz.WithReceptions, error) {
-	ret := _m.Called(ctx, startDate, endDate, city, page, limit)
+// GetPVZs provides a mock function with given fields: ctx, req
+func (_m *Repository) GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) (*pvz.Page, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPVZs")
 	}
 
+	var r0 *pvz.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pvz.GetPVZsRequest) (*pvz.Page, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pvz.GetPVZsRequest) *pvz.Page); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pvz.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pvz.GetPVZsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPVZsByIDs provides a mock function with given fields: ctx, ids
+func (_m *Repository) GetPVZsByIDs(ctx context.Context, ids []uuid.UUID) ([]pvz.WithReceptions, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPVZsByIDs")
+	}
+
 	var r0 []pvz.WithReceptions
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *time.Time, *time.Time, *pvz.City, int, int) ([]pvz.WithReceptions, error)); ok {
-		return rf(ctx, startDate, endDate, city, page, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]pvz.WithReceptions, error)); ok {
+		return rf(ctx, ids)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *time.Time, *time.Time, *pvz.City, int, int) []pvz.WithReceptions); ok {
-		r0 = rf(ctx, startDate, endDate, city, page, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []pvz.WithReceptions); ok {
+		r0 = rf(ctx, ids)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]pvz.WithReceptions)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *time.Time, *time.Time, *pvz.City, int, int) error); ok {
-		r1 = rf(ctx, startDate, endDate, city, page, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,9 +3,12 @@ package pvz
 import (
 	"context"
 	"fmt"
-	"time"
+	"strconv"
 
+	"avito/internal/domain/cursor"
+	"avito/internal/domain/product"
 	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
 
 	"github.com/google/uuid"
 )
@@ -17,7 +20,9 @@ type Transactor interface {
 type Repository interface {
 	CreatePVZ(ctx context.Context, city pvz.City) (*pvz.PVZ, error)
 	GetPVZByID(ctx context.Context, id uuid.UUID) (*pvz.PVZ, error)
-	GetPVZs(ctx context.Context, startDate, endDate *time.Time, city *pvz.City, page, limit int) ([]pvz.WithReceptions, error)
+	GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) (*pvz.Page, error)
+	CountPVZs(ctx context.Context, req pvz.GetPVZsRequest) (int, error)
+	GetPVZsByIDs(ctx context.Context, ids []uuid.UUID) ([]pvz.WithReceptions, error)
 }
 
 type Service struct {
@@ -49,18 +54,155 @@ func (s *Service) CreatePVZ(ctx context.Context, req pvz.CreatePVZRequest) (*pvz
 	return pvzObj, nil
 }
 
-func (s *Service) GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) ([]pvz.WithReceptions, error) {
-	if req.Page <= 0 {
+// GetPVZs возвращает страницу списка ПВЗ. Страница задается либо номером (page/limit), либо курсором After;
+// курсор не пропускает и не дублирует ПВЗ при одновременной регистрации новых.
+func (s *Service) GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) (*pvz.Page, error) {
+	if req.Page < 0 || req.Limit < 0 || req.Limit > pvz.MaxPageSize {
+		return nil, &pvz.ErrInvalidPaginationParams{}
+	}
+
+	if req.After != nil && req.Page > 1 {
+		return nil, &pvz.ErrInvalidPaginationParams{}
+	}
+
+	if req.Sort.Field == "" {
+		req.Sort.Field = pvz.SortRegistrationDate
+	}
+
+	if err := validateListFilters(req); err != nil {
+		return nil, err
+	}
+
+	// Курсор хранит значение поля сортировки, поэтому в другом порядке он указывает на случайную позицию.
+	if req.After != nil && req.After.Sort != req.Sort {
+		return nil, &cursor.ErrInvalid{}
+	}
+
+	if req.Nested.ReceptionsLimit < 0 || req.Nested.ReceptionsLimit > reception.MaxPageSize ||
+		req.Nested.ProductsLimit < 0 || req.Nested.ProductsLimit > product.MaxPageSize {
+		return nil, &pvz.ErrInvalidPaginationParams{}
+	}
+
+	if req.Nested.OmitReceptions {
+		req.Nested.OmitProducts = true
+	}
+
+	if req.Page == 0 {
 		req.Page = 1
 	}
 
-	if req.Limit <= 0 || req.Limit > 30 {
-		req.Limit = 10
+	if req.Limit == 0 {
+		req.Limit = pvz.DefaultPageSize
 	}
 
-	return s.repo.GetPVZs(ctx, req.StartDate, req.EndDate, req.City, req.Page, req.Limit)
+	page, err := s.repo.GetPVZs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.WithTotal {
+		total, err := s.repo.CountPVZs(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		page.Total = &total
+	}
+
+	return page, nil
+}
+
+func validateListFilters(req pvz.GetPVZsRequest) error {
+	if !req.Sort.Field.Validate() {
+		return &pvz.ValidationError{Key: pvz.DetailUnknownSortField}
+	}
+
+	for _, city := range req.Cities {
+		if !city.Validate() {
+			return &pvz.ErrInvalidCity{}
+		}
+	}
+
+	for _, status := range req.ReceptionStatuses {
+		if !status.Validate() {
+			return &pvz.ValidationError{Key: pvz.DetailInvalidReceptionStatus}
+		}
+	}
+
+	for _, productType := range req.ProductTypes {
+		if !productType.Validate() {
+			return &product.ErrInvalidProductType{}
+		}
+	}
+
+	if req.RegisteredFrom != nil && req.RegisteredTo != nil && req.RegisteredFrom.After(*req.RegisteredTo) {
+		return &pvz.ValidationError{Key: pvz.DetailInvalidRegistrationRange}
+	}
+
+	return nil
 }
 
 func (s *Service) GetPVZByID(ctx context.Context, id uuid.UUID) (*pvz.PVZ, error) {
 	return s.repo.GetPVZByID(ctx, id)
 }
+
+// GetPVZWithReceptions возвращает ПВЗ вместе со всеми его приемками и товарами.
+func (s *Service) GetPVZWithReceptions(ctx context.Context, id uuid.UUID) (*pvz.WithReceptions, error) {
+	items, err := s.repo.GetPVZsByIDs(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, &pvz.ErrPVZNotFound{}
+	}
+
+	return &items[0], nil
+}
+
+// GetPVZsByIDs возвращает найденные ПВЗ в порядке переданных ID, повторяющиеся ID учитываются один раз.
+// Отсутствующие ПВЗ пропускаются.
+func (s *Service) GetPVZsByIDs(ctx context.Context, ids []uuid.UUID) ([]pvz.WithReceptions, error) {
+	if len(ids) == 0 {
+		return nil, &pvz.ValidationError{Key: pvz.DetailEmptyIDs}
+	}
+
+	uniqueIDs := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		uniqueIDs = append(uniqueIDs, id)
+	}
+
+	if len(uniqueIDs) > pvz.MaxBatchSize {
+		return nil, &pvz.ValidationError{
+			Key:    pvz.DetailTooManyIDs,
+			Params: map[string]string{"max": strconv.Itoa(pvz.MaxBatchSize)},
+		}
+	}
+
+	items, err := s.repo.GetPVZsByIDs(ctx, uniqueIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]pvz.WithReceptions, len(items))
+	for _, item := range items {
+		byID[item.PVZ.ID] = item
+	}
+
+	result := make([]pvz.WithReceptions, 0, len(items))
+
+	for _, id := range uniqueIDs {
+		if item, ok := byID[id]; ok {
+			result = append(result, item)
+		}
+	}
+
+	return result, nil
+}
@@ -3,12 +3,16 @@ package pvz_test
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
 	"avito/internal/application/pvz"
 	"avito/internal/application/pvz/mocks"
+	"avito/internal/domain/cursor"
+	"avito/internal/domain/product"
 	domainPvz "avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -98,12 +102,26 @@ func TestService_GetPVZs(t *testing.T) {
 	startDate := now.Add(-24 * time.Hour)
 	endDate := now
 	moscow := domainPvz.CityMoscow
+	byDate := domainPvz.Sort{Field: domainPvz.SortRegistrationDate}
+	after := &domainPvz.Cursor{Sort: byDate, Time: now, ID: uuid.New()}
+	hasOpen := true
+
+	items := []domainPvz.WithReceptions{
+		{
+			PVZ: domainPvz.PVZ{
+				ID:               uuid.New(),
+				RegistrationDate: now,
+				City:             domainPvz.CityMoscow,
+			},
+			Receptions: []domainPvz.ReceptionWithItems{},
+		},
+	}
 
 	tests := []struct {
 		name          string
 		request       domainPvz.GetPVZsRequest
 		mockSetup     func(*mocks.Repository)
-		expectedItems []domainPvz.WithReceptions
+		expectedTotal *int
 		expectedError error
 	}{
 		{
@@ -113,43 +131,110 @@ func TestService_GetPVZs(t *testing.T) {
 				Limit: 0,
 			},
 			mockSetup: func(repo *mocks.Repository) {
-				expectedItems := []domainPvz.WithReceptions{
-					{
-						PVZ: domainPvz.PVZ{
-							ID:               uuid.New(),
-							RegistrationDate: now,
-							City:             domainPvz.CityMoscow,
-						},
-						Receptions: []domainPvz.ReceptionWithItems{},
-					},
-				}
-				repo.On("GetPVZs", mock.Anything, mock.Anything, mock.Anything, mock.Anything, 1, 10).Return(expectedItems, nil)
-			},
-			expectedItems: []domainPvz.WithReceptions{
-				{
-					PVZ: domainPvz.PVZ{
-						City: domainPvz.CityMoscow,
-					},
-				},
+				repo.On("GetPVZs", mock.Anything, domainPvz.GetPVZsRequest{Sort: byDate, Page: 1, Limit: 10}).
+					Return(&domainPvz.Page{Items: items}, nil)
 			},
-			expectedError: nil,
 		},
 		{
 			name: "Получение ПВЗ с фильтрацией",
 			request: domainPvz.GetPVZsRequest{
 				StartDate: &startDate,
 				EndDate:   &endDate,
-				City:      &moscow,
+				Cities:    []domainPvz.City{moscow},
 				Page:      2,
 				Limit:     5,
 			},
 			mockSetup: func(repo *mocks.Repository) {
-				expectedItems := []domainPvz.WithReceptions{}
-				repo.On("GetPVZs", mock.Anything, mock.AnythingOfType("*time.Time"),
-					mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz.City"), 2, 5).Return(expectedItems, nil)
+				repo.On("GetPVZs", mock.Anything, domainPvz.GetPVZsRequest{
+					StartDate: &startDate,
+					EndDate:   &endDate,
+					Cities:    []domainPvz.City{moscow},
+					Sort:      byDate,
+					Page:      2,
+					Limit:     5,
+				}).Return(&domainPvz.Page{}, nil)
+			},
+		},
+		{
+			name:    "Получение страницы по курсору",
+			request: domainPvz.GetPVZsRequest{After: after, Limit: 5},
+			mockSetup: func(repo *mocks.Repository) {
+				repo.On("GetPVZs", mock.Anything, domainPvz.GetPVZsRequest{After: after, Sort: byDate, Page: 1, Limit: 5}).
+					Return(&domainPvz.Page{Items: items}, nil)
 			},
-			expectedItems: []domainPvz.WithReceptions{},
-			expectedError: nil,
+		},
+		{
+			name:    "Общее количество по запросу",
+			request: domainPvz.GetPVZsRequest{Cities: []domainPvz.City{moscow}, WithTotal: true},
+			mockSetup: func(repo *mocks.Repository) {
+				req := domainPvz.GetPVZsRequest{
+					Cities:    []domainPvz.City{moscow},
+					Sort:      byDate,
+					Page:      1,
+					Limit:     10,
+					WithTotal: true,
+				}
+				repo.On("GetPVZs", mock.Anything, req).Return(&domainPvz.Page{Items: items}, nil)
+				repo.On("CountPVZs", mock.Anything, req).Return(42, nil)
+			},
+			expectedTotal: intPtr(42),
+		},
+		{
+			name: "Фильтры приемок и сортировка по количеству приемок",
+			request: domainPvz.GetPVZsRequest{
+				ReceptionStatuses: []reception.Status{reception.StatusInProgress},
+				ProductTypes:      []product.Type{product.TypeShoes, product.TypeClothes},
+				HasOpenReception:  &hasOpen,
+				Sort:              domainPvz.Sort{Field: domainPvz.SortReceptionCount, Asc: true},
+			},
+			mockSetup: func(repo *mocks.Repository) {
+				repo.On("GetPVZs", mock.Anything, domainPvz.GetPVZsRequest{
+					ReceptionStatuses: []reception.Status{reception.StatusInProgress},
+					ProductTypes:      []product.Type{product.TypeShoes, product.TypeClothes},
+					HasOpenReception:  &hasOpen,
+					Sort:              domainPvz.Sort{Field: domainPvz.SortReceptionCount, Asc: true},
+					Page:              1,
+					Limit:             10,
+				}).Return(&domainPvz.Page{Items: items}, nil)
+			},
+		},
+		{
+			name: "Курсор другой сортировки",
+			request: domainPvz.GetPVZsRequest{
+				After: after,
+				Sort:  domainPvz.Sort{Field: domainPvz.SortRegistrationDate, Asc: true},
+			},
+			expectedError: &cursor.ErrInvalid{},
+		},
+		{
+			name:          "Неизвестное поле сортировки",
+			request:       domainPvz.GetPVZsRequest{Sort: domainPvz.Sort{Field: "city"}},
+			expectedError: &domainPvz.ValidationError{Key: domainPvz.DetailUnknownSortField},
+		},
+		{
+			name:          "Неизвестный город",
+			request:       domainPvz.GetPVZsRequest{Cities: []domainPvz.City{moscow, "Тверь"}},
+			expectedError: &domainPvz.ErrInvalidCity{},
+		},
+		{
+			name:          "Неизвестный тип товара",
+			request:       domainPvz.GetPVZsRequest{ProductTypes: []product.Type{"мебель"}},
+			expectedError: &product.ErrInvalidProductType{},
+		},
+		{
+			name:          "Начало диапазона регистрации позже конца",
+			request:       domainPvz.GetPVZsRequest{RegisteredFrom: &endDate, RegisteredTo: &startDate},
+			expectedError: &domainPvz.ValidationError{Key: domainPvz.DetailInvalidRegistrationRange},
+		},
+		{
+			name:          "Лимит больше максимального",
+			request:       domainPvz.GetPVZsRequest{Limit: domainPvz.MaxPageSize + 1},
+			expectedError: &domainPvz.ErrInvalidPaginationParams{},
+		},
+		{
+			name:          "Курсор вместе с номером страницы",
+			request:       domainPvz.GetPVZsRequest{After: after, Page: 2},
+			expectedError: &domainPvz.ErrInvalidPaginationParams{},
 		},
 	}
 
@@ -164,13 +249,15 @@ func TestService_GetPVZs(t *testing.T) {
 
 			service := pvz.NewService(mockRepo, mockTx)
 
-			actualItems, err := service.GetPVZs(context.Background(), tt.request)
+			page, err := service.GetPVZs(context.Background(), tt.request)
 
 			if tt.expectedError != nil {
-				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, page)
 			} else {
-				assert.NoError(t, err)
-				assert.NotNil(t, actualItems)
+				require.NoError(t, err)
+				require.NotNil(t, page)
+				assert.Equal(t, tt.expectedTotal, page.Total)
 			}
 
 			mockRepo.AssertExpectations(t)
@@ -178,6 +265,10 @@ func TestService_GetPVZs(t *testing.T) {
 	}
 }
 
+func intPtr(v int) *int {
+	return &v
+}
+
 func TestService_GetPVZByID(t *testing.T) {
 	pvzID := uuid.New()
 
@@ -244,3 +335,148 @@ func TestService_GetPVZByID(t *testing.T) {
 		})
 	}
 }
+
+func TestService_GetPVZWithReceptions(t *testing.T) {
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(*mocks.Repository)
+		expectedError error
+	}{
+		{
+			name: "Успешное получение ПВЗ с приемками",
+			mockSetup: func(repo *mocks.Repository) {
+				repo.On("GetPVZsByIDs", mock.Anything, []uuid.UUID{pvzID}).Return([]domainPvz.WithReceptions{
+					{
+						PVZ: domainPvz.PVZ{
+							ID:               pvzID,
+							RegistrationDate: time.Now(),
+							City:             domainPvz.CityKazan,
+						},
+						Receptions: []domainPvz.ReceptionWithItems{},
+					},
+				}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "ПВЗ не найден",
+			mockSetup: func(repo *mocks.Repository) {
+				repo.On("GetPVZsByIDs", mock.Anything, []uuid.UUID{pvzID}).Return([]domainPvz.WithReceptions{}, nil)
+			},
+			expectedError: &domainPvz.ErrPVZNotFound{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.Repository)
+			mockTx := new(mocks.Transactor)
+
+			tt.mockSetup(mockRepo)
+
+			service := pvz.NewService(mockRepo, mockTx)
+
+			item, err := service.GetPVZWithReceptions(context.Background(), pvzID)
+
+			if tt.expectedError != nil {
+				assert.IsType(t, tt.expectedError, err)
+				assert.Nil(t, item)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, item)
+				assert.Equal(t, pvzID, item.PVZ.ID)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetPVZsByIDs(t *testing.T) {
+	firstID := uuid.New()
+	secondID := uuid.New()
+	missingID := uuid.New()
+
+	tooManyIDs := make([]uuid.UUID, 0, domainPvz.MaxBatchSize+1)
+	for i := 0; i <= domainPvz.MaxBatchSize; i++ {
+		tooManyIDs = append(tooManyIDs, uuid.New())
+	}
+
+	// Лимит проверяется после удаления дубликатов.
+	maxIDs := tooManyIDs[:domainPvz.MaxBatchSize]
+	maxIDsWithDuplicate := append(slices.Clone(maxIDs), maxIDs[0])
+
+	tests := []struct {
+		name          string
+		ids           []uuid.UUID
+		mockSetup     func(*mocks.Repository)
+		expectedIDs   []uuid.UUID
+		expectedError error
+	}{
+		{
+			name: "Порядок запроса сохраняется, дубликаты и отсутствующие ПВЗ отбрасываются",
+			ids:  []uuid.UUID{secondID, missingID, firstID, secondID},
+			mockSetup: func(repo *mocks.Repository) {
+				repo.On("GetPVZsByIDs", mock.Anything, []uuid.UUID{secondID, missingID, firstID}).
+					Return([]domainPvz.WithReceptions{
+						{PVZ: domainPvz.PVZ{ID: firstID, City: domainPvz.CityMoscow}},
+						{PVZ: domainPvz.PVZ{ID: secondID, City: domainPvz.CityKazan}},
+					}, nil)
+			},
+			expectedIDs:   []uuid.UUID{secondID, firstID},
+			expectedError: nil,
+		},
+		{
+			name:          "Пустой список ID",
+			ids:           nil,
+			mockSetup:     func(repo *mocks.Repository) {},
+			expectedError: &domainPvz.ValidationError{},
+		},
+		{
+			name: "Дубликаты не учитываются в лимите",
+			ids:  maxIDsWithDuplicate,
+			mockSetup: func(repo *mocks.Repository) {
+				repo.On("GetPVZsByIDs", mock.Anything, maxIDs).Return([]domainPvz.WithReceptions{}, nil)
+			},
+			expectedIDs:   []uuid.UUID{},
+			expectedError: nil,
+		},
+		{
+			name:          "Слишком много ID",
+			ids:           tooManyIDs,
+			mockSetup:     func(repo *mocks.Repository) {},
+			expectedError: &domainPvz.ValidationError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.Repository)
+			mockTx := new(mocks.Transactor)
+
+			tt.mockSetup(mockRepo)
+
+			service := pvz.NewService(mockRepo, mockTx)
+
+			items, err := service.GetPVZsByIDs(context.Background(), tt.ids)
+
+			if tt.expectedError != nil {
+				assert.IsType(t, tt.expectedError, err)
+				assert.Nil(t, items)
+			} else {
+				require.NoError(t, err)
+
+				actualIDs := make([]uuid.UUID, 0, len(items))
+				for _, item := range items {
+					actualIDs = append(actualIDs, item.PVZ.ID)
+				}
+
+				assert.Equal(t, tt.expectedIDs, actualIDs)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -136,6 +136,36 @@ func (_m *Repository) GetReceptionByID(ctx context.Context, id uuid.UUID) (*rece
 	return r0, r1
 }
 
+// ListReceptionsByPVZID provides a mock function with given fields: ctx, req
+func (_m *Repository) ListReceptionsByPVZID(ctx context.Context, req reception.ListRequest) (*reception.Page, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReceptionsByPVZID")
+	}
+
+	var r0 *reception.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, reception.ListRequest) (*reception.Page, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, reception.ListRequest) *reception.Page); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reception.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, reception.ListRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRepository(t interface {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"avito/internal/domain/event"
 	domainPVZ "avito/internal/domain/pvz"
 	"avito/internal/domain/reception"
+	"avito/pkg/txs"
 
 	"github.com/google/uuid"
 )
@@ -19,27 +21,37 @@ type Repository interface {
 	GetActiveReceptionByPVZID(ctx context.Context, pvzID uuid.UUID) (*reception.Reception, error)
 	GetReceptionByID(ctx context.Context, id uuid.UUID) (*reception.Reception, error)
 	CloseReception(ctx context.Context, id uuid.UUID) (*reception.Reception, error)
+	ListReceptionsByPVZID(ctx context.Context, req reception.ListRequest) (*reception.Page, error)
 }
 
 type PVZRepository interface {
 	GetPVZByID(ctx context.Context, id uuid.UUID) (*domainPVZ.PVZ, error)
 }
 
+type EventPublisher interface {
+	Publish(ctx context.Context, e event.Event)
+}
+
 type Service struct {
 	repo      Repository
 	pvzRepo   PVZRepository
 	txManager Transactor
+	publisher EventPublisher
 }
 
-func NewService(repo Repository, pvzRepo PVZRepository, txManager Transactor) *Service {
+func NewService(repo Repository, pvzRepo PVZRepository, txManager Transactor, publisher EventPublisher) *Service {
 	return &Service{
 		repo:      repo,
 		pvzRepo:   pvzRepo,
 		txManager: txManager,
+		publisher: publisher,
 	}
 }
 
 func (s *Service) CreateReception(ctx context.Context, req reception.CreateReceptionRequest) (*reception.Reception, error) {
+	// ПВЗ мог быть создан только что и еще не дойти до реплик.
+	ctx = txs.WithPrimary(ctx)
+
 	_, err := s.pvzRepo.GetPVZByID(ctx, req.PVZID)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при проверке ПВЗ: %w", err)
@@ -57,9 +69,19 @@ func (s *Service) CreateReception(ctx context.Context, req reception.CreateRecep
 			return fmt.Errorf("ошибка при проверке активной приемки: %w", err)
 		}
 
-		receptionObj, err = s.repo.CreateReception(txCtx, req.PVZID)
+		if receptionObj, err = s.repo.CreateReception(txCtx, req.PVZID); err != nil {
+			return err
+		}
+
+		txs.AfterCommit(txCtx, func() {
+			s.publisher.Publish(ctx, event.Event{
+				Type:        event.TypeReceptionCreated,
+				PVZID:       receptionObj.PVZID,
+				ReceptionID: receptionObj.ID,
+			})
+		})
 
-		return err
+		return nil
 	})
 
 	if err != nil {
@@ -79,9 +101,19 @@ func (s *Service) CloseReception(ctx context.Context, pvzID uuid.UUID) (*recepti
 
 	err = s.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
 		var err error
-		closedReception, err = s.repo.CloseReception(txCtx, activeReception.ID)
+		if closedReception, err = s.repo.CloseReception(txCtx, activeReception.ID); err != nil {
+			return err
+		}
+
+		txs.AfterCommit(txCtx, func() {
+			s.publisher.Publish(ctx, event.Event{
+				Type:        event.TypeReceptionClosed,
+				PVZID:       closedReception.PVZID,
+				ReceptionID: closedReception.ID,
+			})
+		})
 
-		return err
+		return nil
 	})
 
 	if err != nil {
@@ -92,6 +124,9 @@ func (s *Service) CloseReception(ctx context.Context, pvzID uuid.UUID) (*recepti
 }
 
 func (s *Service) GetActiveReception(ctx context.Context, pvzID uuid.UUID) (*reception.Reception, error) {
+	// Приемки читаются из primary, поэтому ПВЗ проверяется там же: на отстающей реплике его может еще не быть.
+	ctx = txs.WithPrimary(ctx)
+
 	_, err := s.pvzRepo.GetPVZByID(ctx, pvzID)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при проверке ПВЗ: %w", err)
@@ -99,3 +134,22 @@ func (s *Service) GetActiveReception(ctx context.Context, pvzID uuid.UUID) (*rec
 
 	return s.repo.GetActiveReceptionByPVZID(ctx, pvzID)
 }
+
+// ListReceptions возвращает страницу приемок ПВЗ от новых к старым.
+func (s *Service) ListReceptions(ctx context.Context, req reception.ListRequest) (*reception.Page, error) {
+	if req.Limit < 0 || req.Limit > reception.MaxPageSize {
+		return nil, &domainPVZ.ErrInvalidPaginationParams{}
+	}
+
+	if req.Limit == 0 {
+		req.Limit = reception.DefaultPageSize
+	}
+
+	ctx = txs.WithPrimary(ctx)
+
+	if _, err := s.pvzRepo.GetPVZByID(ctx, req.PVZID); err != nil {
+		return nil, fmt.Errorf("ошибка при проверке ПВЗ: %w", err)
+	}
+
+	return s.repo.ListReceptionsByPVZID(ctx, req)
+}
@@ -9,6 +9,8 @@ import (
 
 	"avito/internal/application/reception"
 	"avito/internal/application/reception/mocks"
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/event"
 	domainPVZ "avito/internal/domain/pvz"
 	domainReception "avito/internal/domain/reception"
 
@@ -121,12 +123,19 @@ func TestService_CreateReception(t *testing.T) {
 			mockRepo := new(mocks.Repository)
 			mockPVZRepo := new(mocks.PVZRepository)
 			mockTx := new(mocks.Transactor)
+			mockPublisher := new(mocks.EventPublisher)
+
+			if tt.expectedErrorText == "" {
+				mockPublisher.On("Publish", mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+					return e.Type == event.TypeReceptionCreated
+				})).Once()
+			}
 
 			if tt.mockSetup != nil {
 				tt.mockSetup(mockRepo, mockPVZRepo, mockTx)
 			}
 
-			service := reception.NewService(mockRepo, mockPVZRepo, mockTx)
+			service := reception.NewService(mockRepo, mockPVZRepo, mockTx, mockPublisher)
 
 			result, err := service.CreateReception(context.Background(), tt.request)
 
@@ -146,6 +155,7 @@ func TestService_CreateReception(t *testing.T) {
 			mockRepo.AssertExpectations(t)
 			mockPVZRepo.AssertExpectations(t)
 			mockTx.AssertExpectations(t)
+			mockPublisher.AssertExpectations(t)
 		})
 	}
 }
@@ -241,12 +251,19 @@ func TestService_CloseReception(t *testing.T) {
 			mockRepo := new(mocks.Repository)
 			mockPVZRepo := new(mocks.PVZRepository)
 			mockTx := new(mocks.Transactor)
+			mockPublisher := new(mocks.EventPublisher)
+
+			if tt.expectedErrorText == "" {
+				mockPublisher.On("Publish", mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+					return e.Type == event.TypeReceptionClosed
+				})).Once()
+			}
 
 			if tt.mockSetup != nil {
 				tt.mockSetup(mockRepo, mockTx)
 			}
 
-			service := reception.NewService(mockRepo, mockPVZRepo, mockTx)
+			service := reception.NewService(mockRepo, mockPVZRepo, mockTx, mockPublisher)
 
 			result, err := service.CloseReception(context.Background(), tt.pvzID)
 
@@ -265,6 +282,7 @@ func TestService_CloseReception(t *testing.T) {
 
 			mockRepo.AssertExpectations(t)
 			mockTx.AssertExpectations(t)
+			mockPublisher.AssertExpectations(t)
 		})
 	}
 }
@@ -338,12 +356,13 @@ func TestService_GetActiveReception(t *testing.T) {
 			mockRepo := new(mocks.Repository)
 			mockPVZRepo := new(mocks.PVZRepository)
 			mockTx := new(mocks.Transactor)
+			mockPublisher := new(mocks.EventPublisher)
 
 			if tt.mockSetup != nil {
 				tt.mockSetup(mockRepo, mockPVZRepo)
 			}
 
-			service := reception.NewService(mockRepo, mockPVZRepo, mockTx)
+			service := reception.NewService(mockRepo, mockPVZRepo, mockTx, mockPublisher)
 
 			result, err := service.GetActiveReception(context.Background(), tt.pvzID)
 
@@ -365,3 +384,78 @@ func TestService_GetActiveReception(t *testing.T) {
 		})
 	}
 }
+
+func TestService_ListReceptions(t *testing.T) {
+	pvzID := uuid.New()
+	after := &domainReception.Cursor{DateTime: time.Now(), ID: uuid.New()}
+
+	tests := []struct {
+		name          string
+		request       domainReception.ListRequest
+		mockSetup     func(*mocks.Repository, *mocks.PVZRepository)
+		expectedError error
+	}{
+		{
+			name:    "Первая страница с лимитом по умолчанию",
+			request: domainReception.ListRequest{PVZID: pvzID},
+			mockSetup: func(repo *mocks.Repository, pvzRepo *mocks.PVZRepository) {
+				pvzRepo.On("GetPVZByID", mock.Anything, pvzID).Return(&domainPVZ.PVZ{ID: pvzID}, nil)
+				repo.On("ListReceptionsByPVZID", mock.Anything, domainReception.ListRequest{
+					PVZID: pvzID,
+					Limit: domainReception.DefaultPageSize,
+				}).Return(&domainReception.Page{}, nil)
+			},
+		},
+		{
+			name:    "Страница по курсору",
+			request: domainReception.ListRequest{PVZID: pvzID, After: after, Limit: 5},
+			mockSetup: func(repo *mocks.Repository, pvzRepo *mocks.PVZRepository) {
+				pvzRepo.On("GetPVZByID", mock.Anything, pvzID).Return(&domainPVZ.PVZ{ID: pvzID}, nil)
+				repo.On("ListReceptionsByPVZID", mock.Anything, domainReception.ListRequest{
+					PVZID: pvzID,
+					After: after,
+					Limit: 5,
+				}).Return(&domainReception.Page{}, nil)
+			},
+		},
+		{
+			name:    "ПВЗ не найден",
+			request: domainReception.ListRequest{PVZID: pvzID},
+			mockSetup: func(repo *mocks.Repository, pvzRepo *mocks.PVZRepository) {
+				pvzRepo.On("GetPVZByID", mock.Anything, pvzID).Return(nil, &domainPVZ.ErrPVZNotFound{})
+			},
+			expectedError: &domainPVZ.ErrPVZNotFound{},
+		},
+		{
+			name:          "Лимит больше максимального",
+			request:       domainReception.ListRequest{PVZID: pvzID, Limit: domainReception.MaxPageSize + 1},
+			expectedError: &domainPVZ.ErrInvalidPaginationParams{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.Repository)
+			mockPVZRepo := new(mocks.PVZRepository)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockRepo, mockPVZRepo)
+			}
+
+			service := reception.NewService(mockRepo, mockPVZRepo, new(mocks.Transactor), new(mocks.EventPublisher))
+
+			page, err := service.ListReceptions(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, errcode.Of(tt.expectedError), errcode.Of(err))
+				assert.Nil(t, page)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, page)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockPVZRepo.AssertExpectations(t)
+		})
+	}
+}
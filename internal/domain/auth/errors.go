@@ -1,5 +1,18 @@
 package auth
 
+import "avito/internal/domain/errcode"
+
+// Коды ошибок домена аутентификации.
+const (
+	CodeInvalidRole        errcode.Code = "INVALID_ROLE"
+	CodeUserAlreadyExists  errcode.Code = "USER_ALREADY_EXISTS"
+	CodeUserNotFound       errcode.Code = "USER_NOT_FOUND"
+	CodeInvalidCredentials errcode.Code = "INVALID_CREDENTIALS"
+	CodeEmailEmpty         errcode.Code = "EMAIL_EMPTY"
+	CodePasswordEmpty      errcode.Code = "PASSWORD_EMPTY"
+	CodeRoleEmpty          errcode.Code = "ROLE_EMPTY"
+)
+
 // ErrInvalidRole ошибка при неверной роли пользователя.
 type ErrInvalidRole struct{}
 
@@ -7,6 +20,10 @@ func (e ErrInvalidRole) Error() string {
 	return "неверная роль пользователя"
 }
 
+func (e ErrInvalidRole) Code() errcode.Code {
+	return CodeInvalidRole
+}
+
 // ErrUserAlreadyExists ошибка при попытке создать существующего пользователя.
 type ErrUserAlreadyExists struct{}
 
@@ -14,6 +31,10 @@ func (e ErrUserAlreadyExists) Error() string {
 	return "пользователь с таким email уже существует"
 }
 
+func (e ErrUserAlreadyExists) Code() errcode.Code {
+	return CodeUserAlreadyExists
+}
+
 // ErrUserNotFound ошибка когда пользователь не найден.
 type ErrUserNotFound struct{}
 
@@ -21,6 +42,10 @@ func (e ErrUserNotFound) Error() string {
 	return "пользователь не найден"
 }
 
+func (e ErrUserNotFound) Code() errcode.Code {
+	return CodeUserNotFound
+}
+
 // ErrInvalidCredentials ошибка при неверных учетных данных.
 type ErrInvalidCredentials struct{}
 
@@ -28,6 +53,10 @@ func (e ErrInvalidCredentials) Error() string {
 	return "неверный email или пароль"
 }
 
+func (e ErrInvalidCredentials) Code() errcode.Code {
+	return CodeInvalidCredentials
+}
+
 // ErrEmptyToken ошибка при пустом токене аутентификации.
 type ErrEmptyToken struct{}
 
@@ -35,6 +64,10 @@ func (e ErrEmptyToken) Error() string {
 	return "пустой токен"
 }
 
+func (e ErrEmptyToken) Code() errcode.Code {
+	return errcode.Unauthenticated
+}
+
 // ErrEmailEmpty ошибка при пустом email.
 type ErrEmailEmpty struct{}
 
@@ -42,6 +75,10 @@ func (e ErrEmailEmpty) Error() string {
 	return "email не может быть пустым"
 }
 
+func (e ErrEmailEmpty) Code() errcode.Code {
+	return CodeEmailEmpty
+}
+
 // ErrPasswordEmpty ошибка при пустом пароле.
 type ErrPasswordEmpty struct{}
 
@@ -49,6 +86,10 @@ func (e ErrPasswordEmpty) Error() string {
 	return "пароль не может быть пустым"
 }
 
+func (e ErrPasswordEmpty) Code() errcode.Code {
+	return CodePasswordEmpty
+}
+
 // ErrRoleEmpty ошибка при пустой роли.
 type ErrRoleEmpty struct{}
 
@@ -56,6 +97,10 @@ func (e ErrRoleEmpty) Error() string {
 	return "роль не может быть пустой"
 }
 
+func (e ErrRoleEmpty) Code() errcode.Code {
+	return CodeRoleEmpty
+}
+
 // ValidationError ошибка валидации пользователя.
 type ValidationError struct {
 	Message string
@@ -64,3 +109,7 @@ type ValidationError struct {
 func (e ValidationError) Error() string {
 	return e.Message
 }
+
+func (e ValidationError) Code() errcode.Code {
+	return errcode.ValidationFailed
+}
@@ -0,0 +1,45 @@
+// Package cursor кодирует позиции keyset-пагинации в непрозрачные для клиента строки.
+// Позицию описывает каждый домен сам, здесь только общий формат и ошибка разбора.
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"avito/internal/domain/errcode"
+)
+
+// CodeInvalid - код ошибки для поврежденного или чужого курсора.
+const CodeInvalid errcode.Code = "INVALID_CURSOR"
+
+// ErrInvalid ошибка при поврежденном или чужом курсоре пагинации.
+type ErrInvalid struct{}
+
+func (e ErrInvalid) Error() string {
+	return "неверный курсор пагинации"
+}
+
+func (e ErrInvalid) Code() errcode.Code {
+	return CodeInvalid
+}
+
+// Encode возвращает строковое представление позиции.
+func Encode(position any) string {
+	data, _ := json.Marshal(position)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode разбирает строку из Encode в position. Любая ошибка разбора возвращается как ErrInvalid.
+func Decode(value string, position any) error {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return &ErrInvalid{}
+	}
+
+	if err := json.Unmarshal(data, position); err != nil {
+		return &ErrInvalid{}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package errcode
+
+import (
+	"errors"
+	"strings"
+)
+
+// DetailKey - стабильный ключ подробного сообщения. Общие коды вроде VALIDATION_FAILED и INVALID_REQUEST
+// не описывают конкретный случай, поэтому ошибки уточняют его ключом, по которому переводится сообщение.
+type DetailKey string
+
+// Ключи подробных сообщений об ошибках разбора запроса, общие для всех доменов.
+const (
+	DetailMalformedBody      DetailKey = "MALFORMED_BODY"
+	DetailInvalidParams      DetailKey = "INVALID_PARAMS"
+	DetailMissingParam       DetailKey = "MISSING_PARAM"
+	DetailInvalidParamFormat DetailKey = "INVALID_PARAM_FORMAT"
+	DetailTooManyParamValues DetailKey = "TOO_MANY_PARAM_VALUES"
+	DetailSpecViolation      DetailKey = "SPEC_VIOLATION"
+)
+
+// Detail - подробное сообщение об ошибке. Text - исходный текст на русском. Key и Params задаются,
+// если сообщение можно перевести: по ключу выбирается шаблон, значения параметров подставляются в него.
+type Detail struct {
+	Key    DetailKey
+	Params map[string]string
+	Text   string
+}
+
+// Detailer реализуют ошибки, сообщение которых переводится по ключу.
+type Detailer interface {
+	Detail() Detail
+}
+
+// DetailOf возвращает подробное сообщение первой ошибки в цепочке, у которой есть ключ,
+// или сообщение без ключа с текстом err.
+func DetailOf(err error) Detail {
+	var detailer Detailer
+	if errors.As(err, &detailer) {
+		return detailer.Detail()
+	}
+
+	return Detail{Text: err.Error()}
+}
+
+// Format подставляет значения параметров в шаблон, где параметры записаны как {name}.
+func Format(template string, params map[string]string) string {
+	for name, value := range params {
+		template = strings.ReplaceAll(template, "{"+name+"}", value)
+	}
+
+	return template
+}
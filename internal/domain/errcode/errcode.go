@@ -0,0 +1,36 @@
+// Package errcode содержит тип машиночитаемых кодов ошибок и общие коды, не относящиеся к конкретному домену.
+// Коды доменных ошибок объявлены рядом с самими ошибками в пакетах internal/domain/*.
+package errcode
+
+import "errors"
+
+// Code - стабильный код ошибки, который получают клиенты HTTP и gRPC API.
+// Значения не меняются между версиями, в отличие от текстов сообщений.
+type Code string
+
+const (
+	Internal         Code = "INTERNAL"
+	ValidationFailed Code = "VALIDATION_FAILED"
+	InvalidRequest   Code = "INVALID_REQUEST"
+	Unauthenticated  Code = "UNAUTHENTICATED"
+	InvalidToken     Code = "INVALID_TOKEN"
+	PermissionDenied Code = "PERMISSION_DENIED"
+	RouteNotFound    Code = "ROUTE_NOT_FOUND"
+	MethodNotAllowed Code = "METHOD_NOT_ALLOWED"
+	ShuttingDown     Code = "SHUTTING_DOWN"
+)
+
+// Coder реализуют ошибки, у которых есть код.
+type Coder interface {
+	Code() Code
+}
+
+// Of возвращает код первой ошибки в цепочке, у которой он есть, или Internal.
+func Of(err error) Code {
+	var coder Coder
+	if errors.As(err, &coder) {
+		return coder.Code()
+	}
+
+	return Internal
+}
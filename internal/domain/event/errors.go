@@ -0,0 +1,31 @@
+package event
+
+import "avito/internal/domain/errcode"
+
+// Коды ошибок домена событий.
+const (
+	CodeCursorExpired     errcode.Code = "EVENT_CURSOR_EXPIRED"
+	CodeSubscriberTooSlow errcode.Code = "SUBSCRIBER_TOO_SLOW"
+)
+
+// ErrCursorExpired ошибка, когда события после курсора уже недоступны для повторной отправки.
+type ErrCursorExpired struct{}
+
+func (e ErrCursorExpired) Error() string {
+	return "курсор событий устарел, необходимо заново загрузить состояние"
+}
+
+func (e ErrCursorExpired) Code() errcode.Code {
+	return CodeCursorExpired
+}
+
+// ErrSubscriberTooSlow ошибка, когда подписчик не успевает вычитывать события.
+type ErrSubscriberTooSlow struct{}
+
+func (e ErrSubscriberTooSlow) Error() string {
+	return "подписчик не успевает обрабатывать события"
+}
+
+func (e ErrSubscriberTooSlow) Code() errcode.Code {
+	return CodeSubscriberTooSlow
+}
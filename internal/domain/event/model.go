@@ -0,0 +1,29 @@
+package event
+
+import (
+	"time"
+
+	"avito/internal/domain/product"
+
+	"github.com/google/uuid"
+)
+
+type Type string
+
+const (
+	TypeReceptionCreated Type = "reception_created"
+	TypeReceptionClosed  Type = "reception_closed"
+	TypeProductAdded     Type = "product_added"
+	TypeProductDeleted   Type = "product_deleted"
+)
+
+// Event описывает изменение приемки или ее товаров после успешного commit.
+// Sequence назначается брокером и монотонно возрастает в рамках одного процесса.
+type Event struct {
+	Sequence    uint64           `json:"sequence"`
+	Type        Type             `json:"type"`
+	OccurredAt  time.Time        `json:"occurredAt"`
+	PVZID       uuid.UUID        `json:"pvzId"`
+	ReceptionID uuid.UUID        `json:"receptionId"`
+	Product     *product.Product `json:"product,omitempty"`
+}
@@ -0,0 +1,38 @@
+package product
+
+import "avito/internal/domain/cursor"
+
+// Cursor - позиция в списке товаров приемки, упорядоченном по порядковому номеру.
+type Cursor struct {
+	SequenceNumber int
+}
+
+type cursorPayload struct {
+	SequenceNumber int `json:"s"`
+}
+
+// CursorAfter возвращает курсор, указывающий на позицию сразу после p.
+func CursorAfter(p Product) *Cursor {
+	return &Cursor{SequenceNumber: p.SequenceNumber}
+}
+
+// Encode возвращает непрозрачное для клиента представление курсора.
+func (c Cursor) Encode() string {
+	return cursor.Encode(cursorPayload(c))
+}
+
+// DecodeCursor разбирает курсор, полученный от клиента.
+func DecodeCursor(value string) (*Cursor, error) {
+	var payload cursorPayload
+	if err := cursor.Decode(value, &payload); err != nil {
+		return nil, err
+	}
+
+	if payload.SequenceNumber < 1 {
+		return nil, &cursor.ErrInvalid{}
+	}
+
+	c := Cursor(payload)
+
+	return &c, nil
+}
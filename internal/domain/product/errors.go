@@ -1,5 +1,15 @@
 package product
 
+import "avito/internal/domain/errcode"
+
+// Коды ошибок домена товаров.
+const (
+	CodeInvalidProductType errcode.Code = "INVALID_PRODUCT_TYPE"
+	CodeNoProductsToDelete errcode.Code = "NO_PRODUCTS_TO_DELETE"
+	CodeProductNotFound    errcode.Code = "PRODUCT_NOT_FOUND"
+	CodeTypeEmpty          errcode.Code = "PRODUCT_TYPE_EMPTY"
+)
+
 // ErrInvalidProductType ошибка при неверном типе товара.
 type ErrInvalidProductType struct{}
 
@@ -7,6 +17,10 @@ func (e ErrInvalidProductType) Error() string {
 	return "неверный тип товара"
 }
 
+func (e ErrInvalidProductType) Code() errcode.Code {
+	return CodeInvalidProductType
+}
+
 // ErrNoProductsToDelete ошибка, когда нет товаров для удаления.
 type ErrNoProductsToDelete struct{}
 
@@ -14,6 +28,10 @@ func (e ErrNoProductsToDelete) Error() string {
 	return "нет товаров для удаления"
 }
 
+func (e ErrNoProductsToDelete) Code() errcode.Code {
+	return CodeNoProductsToDelete
+}
+
 // ErrProductNotFound ошибка, когда товар не найден.
 type ErrProductNotFound struct{}
 
@@ -21,6 +39,10 @@ func (e ErrProductNotFound) Error() string {
 	return "товар не найден"
 }
 
+func (e ErrProductNotFound) Code() errcode.Code {
+	return CodeProductNotFound
+}
+
 // ErrTypeEmpty ошибка при пустом типе товара.
 type ErrTypeEmpty struct{}
 
@@ -28,11 +50,38 @@ func (e ErrTypeEmpty) Error() string {
 	return "тип товара не может быть пустым"
 }
 
-// ValidationError ошибка валидации товара.
+func (e ErrTypeEmpty) Code() errcode.Code {
+	return CodeTypeEmpty
+}
+
+// Ключи подробных сообщений ValidationError домена товаров.
+const (
+	DetailScanStartExpected  errcode.DetailKey = "SCAN_START_EXPECTED"
+	DetailScanAlreadyStarted errcode.DetailKey = "SCAN_ALREADY_STARTED"
+	DetailUnknownScanCommand errcode.DetailKey = "UNKNOWN_SCAN_COMMAND"
+)
+
+// validationMessages содержит русские сообщения по ключам.
+var validationMessages = map[errcode.DetailKey]string{
+	DetailScanStartExpected:  "первым сообщением должна быть команда start",
+	DetailScanAlreadyStarted: "сессия сканирования уже открыта",
+	DetailUnknownScanCommand: "неизвестная команда сканирования",
+}
+
+// ValidationError ошибка валидации товара. Key определяет сообщение, Params - значения его параметров.
 type ValidationError struct {
-	Message string
+	Key    errcode.DetailKey
+	Params map[string]string
 }
 
 func (e ValidationError) Error() string {
-	return e.Message
+	return errcode.Format(validationMessages[e.Key], e.Params)
+}
+
+func (e ValidationError) Detail() errcode.Detail {
+	return errcode.Detail{Key: e.Key, Params: e.Params, Text: e.Error()}
+}
+
+func (e ValidationError) Code() errcode.Code {
+	return errcode.ValidationFailed
 }
@@ -36,3 +36,24 @@ type CreateProductRequest struct {
 	Type  Type      `json:"type"`
 	PVZID uuid.UUID `json:"pvzId"`
 }
+
+// Размер страницы товаров по умолчанию и максимальный. Максимум также ограничивает
+// количество товаров одной приемки в списке ПВЗ.
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+// ListRequest - запрос страницы товаров приемки в порядке добавления.
+type ListRequest struct {
+	ReceptionID uuid.UUID
+	After       *Cursor
+	Limit       int
+}
+
+// Page - страница товаров.
+type Page struct {
+	Items []Product
+	// Next - курсор следующей страницы, nil на последней странице.
+	Next *Cursor
+}
@@ -0,0 +1,78 @@
+package pvz
+
+import (
+	"time"
+
+	"avito/internal/domain/cursor"
+
+	"github.com/google/uuid"
+)
+
+// Cursor - позиция в списке ПВЗ: значение поля сортировки и ID последнего ПВЗ страницы.
+// ID нужен, чтобы различать ПВЗ с одинаковым значением поля. Курсор действителен только для того порядка,
+// в котором получен.
+type Cursor struct {
+	Sort Sort
+	// Time - значение поля для сортировки по дате регистрации и последней активности.
+	Time time.Time
+	// Count - значение поля для сортировки по количеству приемок.
+	Count int
+	ID    uuid.UUID
+}
+
+// Курсоры без поля сортировки выданы до появления сортировок и означают порядок по умолчанию.
+type cursorPayload struct {
+	Field SortField  `json:"s,omitempty"`
+	Asc   bool       `json:"a,omitempty"`
+	Time  *time.Time `json:"d,omitempty"`
+	Count int        `json:"n,omitempty"`
+	ID    uuid.UUID  `json:"i"`
+}
+
+// Encode возвращает непрозрачное для клиента представление курсора.
+func (c Cursor) Encode() string {
+	payload := cursorPayload{
+		Field: c.Sort.Field,
+		Asc:   c.Sort.Asc,
+		Count: c.Count,
+		ID:    c.ID,
+	}
+
+	if !c.Time.IsZero() {
+		payload.Time = &c.Time
+	}
+
+	return cursor.Encode(payload)
+}
+
+// DecodeCursor разбирает курсор, полученный от клиента.
+func DecodeCursor(value string) (*Cursor, error) {
+	var payload cursorPayload
+	if err := cursor.Decode(value, &payload); err != nil {
+		return nil, err
+	}
+
+	if payload.Field == "" {
+		payload.Field = SortRegistrationDate
+	}
+
+	if payload.ID == uuid.Nil || !payload.Field.Validate() || payload.Count < 0 {
+		return nil, &cursor.ErrInvalid{}
+	}
+
+	c := &Cursor{
+		Sort:  Sort{Field: payload.Field, Asc: payload.Asc},
+		Count: payload.Count,
+		ID:    payload.ID,
+	}
+
+	if payload.Time != nil {
+		c.Time = *payload.Time
+	}
+
+	if c.Sort.Field != SortReceptionCount && c.Time.IsZero() {
+		return nil, &cursor.ErrInvalid{}
+	}
+
+	return c, nil
+}
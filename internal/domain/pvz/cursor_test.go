@@ -0,0 +1,88 @@
+package pvz_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"avito/internal/domain/cursor"
+	"avito/internal/domain/pvz"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	id := uuid.New()
+	date := time.Date(2025, 4, 1, 10, 0, 0, 123456000, time.UTC)
+
+	tests := []struct {
+		name   string
+		cursor pvz.Cursor
+	}{
+		{
+			name:   "По дате регистрации",
+			cursor: pvz.Cursor{Sort: pvz.Sort{Field: pvz.SortRegistrationDate}, Time: date, ID: id},
+		},
+		{
+			name:   "По количеству приемок по возрастанию",
+			cursor: pvz.Cursor{Sort: pvz.Sort{Field: pvz.SortReceptionCount, Asc: true}, Count: 0, ID: id},
+		},
+		{
+			name:   "По последней активности",
+			cursor: pvz.Cursor{Sort: pvz.Sort{Field: pvz.SortLastActivity}, Time: date, ID: id},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := pvz.DecodeCursor(tt.cursor.Encode())
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.cursor.Sort, decoded.Sort)
+			assert.Equal(t, tt.cursor.ID, decoded.ID)
+			assert.Equal(t, tt.cursor.Count, decoded.Count)
+			assert.True(t, tt.cursor.Time.Equal(decoded.Time))
+		})
+	}
+}
+
+func TestDecodeCursor_WithoutSort(t *testing.T) {
+	id := uuid.New()
+	value := base64.RawURLEncoding.EncodeToString([]byte(`{"d":"2025-04-01T10:00:00Z","i":"` + id.String() + `"}`))
+
+	decoded, err := pvz.DecodeCursor(value)
+	require.NoError(t, err)
+
+	assert.Equal(t, pvz.Sort{Field: pvz.SortRegistrationDate}, decoded.Sort)
+	assert.Equal(t, id, decoded.ID)
+	assert.True(t, time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC).Equal(decoded.Time))
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "Не base64", value: "не-курсор"},
+		{name: "Не JSON", value: base64.RawURLEncoding.EncodeToString([]byte("garbage"))},
+		{name: "Без ID", value: base64.RawURLEncoding.EncodeToString([]byte(`{"d":"2025-04-01T10:00:00Z"}`))},
+		{name: "Без даты", value: base64.RawURLEncoding.EncodeToString([]byte(`{"i":"` + uuid.NewString() + `"}`))},
+		{
+			name:  "Неизвестное поле сортировки",
+			value: base64.RawURLEncoding.EncodeToString([]byte(`{"s":"city","d":"2025-04-01T10:00:00Z","i":"` + uuid.NewString() + `"}`)),
+		},
+		{
+			name:  "Отрицательное количество приемок",
+			value: base64.RawURLEncoding.EncodeToString([]byte(`{"s":"receptionCount","n":-1,"i":"` + uuid.NewString() + `"}`)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pvz.DecodeCursor(tt.value)
+			assert.Equal(t, &cursor.ErrInvalid{}, err)
+		})
+	}
+}
@@ -1,5 +1,15 @@
 package pvz
 
+import "avito/internal/domain/errcode"
+
+// Коды ошибок домена ПВЗ.
+const (
+	CodeInvalidCity             errcode.Code = "INVALID_CITY"
+	CodePVZNotFound             errcode.Code = "PVZ_NOT_FOUND"
+	CodeInvalidPaginationParams errcode.Code = "INVALID_PAGINATION_PARAMS"
+	CodeCityEmpty               errcode.Code = "CITY_EMPTY"
+)
+
 // ErrInvalidCity ошибка при неверном городе.
 type ErrInvalidCity struct{}
 
@@ -7,6 +17,10 @@ func (e ErrInvalidCity) Error() string {
 	return "город может быть только Москва, Санкт-Петербург или Казань"
 }
 
+func (e ErrInvalidCity) Code() errcode.Code {
+	return CodeInvalidCity
+}
+
 // ErrPVZNotFound ошибка когда ПВЗ не найден.
 type ErrPVZNotFound struct{}
 
@@ -14,6 +28,10 @@ func (e ErrPVZNotFound) Error() string {
 	return "ПВЗ не найден"
 }
 
+func (e ErrPVZNotFound) Code() errcode.Code {
+	return CodePVZNotFound
+}
+
 // ErrInvalidPaginationParams ошибка при неверных параметрах пагинации.
 type ErrInvalidPaginationParams struct{}
 
@@ -21,6 +39,10 @@ func (e ErrInvalidPaginationParams) Error() string {
 	return "неверные параметры пагинации"
 }
 
+func (e ErrInvalidPaginationParams) Code() errcode.Code {
+	return CodeInvalidPaginationParams
+}
+
 // ErrCityEmpty ошибка при пустом городе.
 type ErrCityEmpty struct{}
 
@@ -28,11 +50,52 @@ func (e ErrCityEmpty) Error() string {
 	return "город не может быть пустым"
 }
 
-// ValidationError ошибка валидации ПВЗ.
+func (e ErrCityEmpty) Code() errcode.Code {
+	return CodeCityEmpty
+}
+
+// Ключи подробных сообщений ValidationError домена ПВЗ.
+const (
+	DetailInvalidPVZID              errcode.DetailKey = "INVALID_PVZ_ID"
+	DetailInvalidReceptionID        errcode.DetailKey = "INVALID_RECEPTION_ID"
+	DetailUnknownSortField          errcode.DetailKey = "UNKNOWN_SORT_FIELD"
+	DetailInvalidReceptionStatus    errcode.DetailKey = "INVALID_RECEPTION_STATUS"
+	DetailUnknownInclude            errcode.DetailKey = "UNKNOWN_INCLUDE"
+	DetailIncludeNoneCombined       errcode.DetailKey = "INCLUDE_NONE_COMBINED"
+	DetailProductsWithoutReceptions errcode.DetailKey = "PRODUCTS_WITHOUT_RECEPTIONS"
+	DetailInvalidRegistrationRange  errcode.DetailKey = "INVALID_REGISTRATION_RANGE"
+	DetailEmptyIDs                  errcode.DetailKey = "EMPTY_PVZ_IDS"
+	DetailTooManyIDs                errcode.DetailKey = "TOO_MANY_PVZ_IDS"
+)
+
+// validationMessages содержит русские шаблоны сообщений по ключам, параметры записываются как {name}.
+var validationMessages = map[errcode.DetailKey]string{
+	DetailInvalidPVZID:              "неверный формат UUID ПВЗ",
+	DetailInvalidReceptionID:        "неверный формат UUID приемки",
+	DetailUnknownSortField:          "неизвестное поле сортировки",
+	DetailInvalidReceptionStatus:    "неверный статус приемки",
+	DetailUnknownInclude:            "неизвестное значение include: {value}",
+	DetailIncludeNoneCombined:       "include=none нельзя сочетать с другими значениями",
+	DetailProductsWithoutReceptions: "товары можно вложить только вместе с приемками",
+	DetailInvalidRegistrationRange:  "начало диапазона дат регистрации позже конца",
+	DetailEmptyIDs:                  "список ID ПВЗ не может быть пустым",
+	DetailTooManyIDs:                "можно запросить не более {max} ПВЗ",
+}
+
+// ValidationError ошибка валидации ПВЗ. Key определяет сообщение, Params - значения его параметров.
 type ValidationError struct {
-	Message string
+	Key    errcode.DetailKey
+	Params map[string]string
 }
 
 func (e ValidationError) Error() string {
-	return e.Message
+	return errcode.Format(validationMessages[e.Key], e.Params)
+}
+
+func (e ValidationError) Detail() errcode.Detail {
+	return errcode.Detail{Key: e.Key, Params: e.Params, Text: e.Error()}
+}
+
+func (e ValidationError) Code() errcode.Code {
+	return errcode.ValidationFailed
 }
@@ -11,6 +11,15 @@ import (
 
 type City string
 
+// MaxBatchSize ограничивает количество ПВЗ в одном пакетном запросе.
+const MaxBatchSize = 30
+
+// Размер страницы списка ПВЗ по умолчанию и максимальный.
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 30
+)
+
 const (
 	CityMoscow          City = "Москва"
 	CitySaintPetersburg City = "Санкт-Петербург"
@@ -36,20 +45,92 @@ type CreatePVZRequest struct {
 	City City `json:"city"`
 }
 
+// SortField - поле сортировки списка ПВЗ.
+type SortField string
+
+const (
+	// SortRegistrationDate - по дате регистрации ПВЗ.
+	SortRegistrationDate SortField = "registrationDate"
+	// SortReceptionCount - по количеству всех приемок ПВЗ.
+	SortReceptionCount SortField = "receptionCount"
+	// SortLastActivity - по времени последнего события: регистрации ПВЗ, создания приемки или добавления товара.
+	SortLastActivity SortField = "lastActivity"
+)
+
+func (f SortField) Validate() bool {
+	switch f {
+	case SortRegistrationDate, SortReceptionCount, SortLastActivity:
+		return true
+	default:
+		return false
+	}
+}
+
+// Sort - порядок списка ПВЗ. При равных значениях поля ПВЗ упорядочиваются по ID в том же направлении.
+// Нулевое значение - от новых к старым по дате регистрации.
+type Sort struct {
+	Field SortField
+	Asc   bool
+}
+
+// GetPVZsRequest - запрос страницы списка ПВЗ. Фильтры объединяются по И, значения одного фильтра - по ИЛИ.
+// Фильтры приемок (StartDate, EndDate, ReceptionStatuses, ProductTypes) выбирают приемки: в список попадают ПВЗ
+// хотя бы с одной выбранной приемкой, и вкладываются только выбранные приемки.
 type GetPVZsRequest struct {
+	// StartDate и EndDate - диапазон дат приемок.
 	StartDate *time.Time `json:"startDate,omitempty"`
 	EndDate   *time.Time `json:"endDate,omitempty"`
-	City      *City      `json:"city,omitempty"`
-	Page      int        `json:"page"`
-	Limit     int        `json:"limit"`
+	Cities    []City     `json:"cities,omitempty"`
+	// ReceptionStatuses оставляет приемки с одним из статусов.
+	ReceptionStatuses []reception.Status `json:"receptionStatuses,omitempty"`
+	// ProductTypes оставляет приемки, в которых есть товар одного из типов.
+	ProductTypes []product.Type `json:"productTypes,omitempty"`
+	// HasOpenReception оставляет ПВЗ с незакрытой приемкой (true) или без нее (false).
+	HasOpenReception *bool `json:"hasOpenReception,omitempty"`
+	// RegisteredFrom и RegisteredTo - диапазон дат регистрации ПВЗ.
+	RegisteredFrom *time.Time `json:"registeredFrom,omitempty"`
+	RegisteredTo   *time.Time `json:"registeredTo,omitempty"`
+	Sort           Sort       `json:"-"`
+	Page           int        `json:"page"`
+	Limit          int        `json:"limit"`
+	// After - позиция, после которой начинается страница. Курсор заменяет page, поэтому вместе с Page > 1 не используется.
+	After *Cursor `json:"-"`
+	// WithTotal - посчитать общее количество ПВЗ, подходящих под фильтры.
+	WithTotal bool `json:"-"`
+	// Nested - какие приемки и товары вложить в каждый ПВЗ.
+	Nested Nested `json:"-"`
+}
+
+// Nested управляет вложенными данными в списке ПВЗ. Нулевое значение вкладывает все приемки и товары.
+type Nested struct {
+	OmitReceptions bool
+	// OmitProducts оставляет приемки без товаров. Без приемок товары не вкладываются.
+	OmitProducts bool
+	// ReceptionsLimit ограничивает количество последних приемок одного ПВЗ, 0 - без ограничения.
+	ReceptionsLimit int
+	// ProductsLimit ограничивает количество первых товаров одной приемки, 0 - без ограничения.
+	ProductsLimit int
+}
+
+// Page - страница списка ПВЗ.
+type Page struct {
+	Items []WithReceptions
+	// Next - курсор следующей страницы, nil на последней странице.
+	Next *Cursor
+	// Total заполняется, только если запрошен через WithTotal.
+	Total *int
 }
 
 type WithReceptions struct {
 	PVZ        PVZ                  `json:"pvz"`
 	Receptions []ReceptionWithItems `json:"receptions"`
+	// HasMoreReceptions - приемки обрезаны по Nested.ReceptionsLimit, остальные доступны постранично.
+	HasMoreReceptions bool `json:"hasMoreReceptions,omitempty"`
 }
 
 type ReceptionWithItems struct {
 	Reception reception.Reception `json:"reception"`
 	Products  []product.Product   `json:"products"`
+	// HasMoreProducts - товары обрезаны по Nested.ProductsLimit, остальные доступны постранично.
+	HasMoreProducts bool `json:"hasMoreProducts,omitempty"`
 }
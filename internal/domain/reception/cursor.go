@@ -0,0 +1,46 @@
+package reception
+
+import (
+	"time"
+
+	"avito/internal/domain/cursor"
+
+	"github.com/google/uuid"
+)
+
+// Cursor - позиция в списке приемок ПВЗ, упорядоченном по убыванию (date_time, id).
+type Cursor struct {
+	DateTime time.Time
+	ID       uuid.UUID
+}
+
+type cursorPayload struct {
+	DateTime time.Time `json:"d"`
+	ID       uuid.UUID `json:"i"`
+}
+
+// CursorAfter возвращает курсор, указывающий на позицию сразу после r.
+func CursorAfter(r Reception) *Cursor {
+	return &Cursor{DateTime: r.DateTime, ID: r.ID}
+}
+
+// Encode возвращает непрозрачное для клиента представление курсора.
+func (c Cursor) Encode() string {
+	return cursor.Encode(cursorPayload(c))
+}
+
+// DecodeCursor разбирает курсор, полученный от клиента.
+func DecodeCursor(value string) (*Cursor, error) {
+	var payload cursorPayload
+	if err := cursor.Decode(value, &payload); err != nil {
+		return nil, err
+	}
+
+	if payload.ID == uuid.Nil || payload.DateTime.IsZero() {
+		return nil, &cursor.ErrInvalid{}
+	}
+
+	c := Cursor(payload)
+
+	return &c, nil
+}
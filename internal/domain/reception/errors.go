@@ -1,5 +1,15 @@
 package reception
 
+import "avito/internal/domain/errcode"
+
+// Коды ошибок домена приемок.
+const (
+	CodeReceptionAlreadyOpen errcode.Code = "RECEPTION_ALREADY_OPEN"
+	CodeNoActiveReception    errcode.Code = "NO_ACTIVE_RECEPTION"
+	CodeReceptionNotFound    errcode.Code = "RECEPTION_NOT_FOUND"
+	CodeReceptionClosed      errcode.Code = "RECEPTION_CLOSED"
+)
+
 // ErrActiveReceptionExists ошибка, когда уже есть активная приемка.
 type ErrActiveReceptionExists struct{}
 
@@ -7,6 +17,10 @@ func (e ErrActiveReceptionExists) Error() string {
 	return "уже есть незакрытая приемка"
 }
 
+func (e ErrActiveReceptionExists) Code() errcode.Code {
+	return CodeReceptionAlreadyOpen
+}
+
 // ErrNoActiveReception ошибка, когда нет активной приемки.
 type ErrNoActiveReception struct{}
 
@@ -14,6 +28,10 @@ func (e ErrNoActiveReception) Error() string {
 	return "нет активной приемки"
 }
 
+func (e ErrNoActiveReception) Code() errcode.Code {
+	return CodeNoActiveReception
+}
+
 // ErrReceptionNotFound ошибка, когда приемка не найдена.
 type ErrReceptionNotFound struct{}
 
@@ -21,6 +39,10 @@ func (e ErrReceptionNotFound) Error() string {
 	return "приемка не найдена"
 }
 
+func (e ErrReceptionNotFound) Code() errcode.Code {
+	return CodeReceptionNotFound
+}
+
 // ErrReceptionClosed ошибка, когда приемка уже закрыта.
 type ErrReceptionClosed struct{}
 
@@ -28,6 +50,10 @@ func (e ErrReceptionClosed) Error() string {
 	return "приемка уже закрыта"
 }
 
+func (e ErrReceptionClosed) Code() errcode.Code {
+	return CodeReceptionClosed
+}
+
 // ValidationError ошибка валидации приемки.
 type ValidationError struct {
 	Message string
@@ -36,3 +62,7 @@ type ValidationError struct {
 func (e ValidationError) Error() string {
 	return e.Message
 }
+
+func (e ValidationError) Code() errcode.Code {
+	return errcode.ValidationFailed
+}
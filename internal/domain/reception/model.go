@@ -13,6 +13,15 @@ const (
 	StatusClosed     Status = "close"
 )
 
+func (s Status) Validate() bool {
+	switch s {
+	case StatusInProgress, StatusClosed:
+		return true
+	default:
+		return false
+	}
+}
+
 type Reception struct {
 	ID        uuid.UUID `json:"id"`
 	DateTime  time.Time `json:"dateTime"`
@@ -24,3 +33,24 @@ type Reception struct {
 type CreateReceptionRequest struct {
 	PVZID uuid.UUID `json:"pvzId"`
 }
+
+// Размер страницы приемок по умолчанию и максимальный. Максимум также ограничивает
+// количество приемок одного ПВЗ в списке ПВЗ.
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
+// ListRequest - запрос страницы приемок ПВЗ, от новых к старым.
+type ListRequest struct {
+	PVZID uuid.UUID
+	After *Cursor
+	Limit int
+}
+
+// Page - страница приемок.
+type Page struct {
+	Items []Reception
+	// Next - курсор следующей страницы, nil на последней странице.
+	Next *Cursor
+}
@@ -0,0 +1,169 @@
+package i18n
+
+import (
+	"avito/internal/domain/auth"
+	"avito/internal/domain/cursor"
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/event"
+	"avito/internal/domain/product"
+	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
+)
+
+// catalog содержит сообщения для каждого кода ошибки. Русский текст совпадает с текстом доменной ошибки.
+// Для VALIDATION_FAILED и INVALID_REQUEST сообщение общее: конкретные случаи переводятся по ключам из details.
+var catalog = map[errcode.Code]map[Language]string{
+	errcode.Internal: {
+		Russian: "внутренняя ошибка сервера",
+		English: "internal server error",
+	},
+	errcode.ValidationFailed: {
+		Russian: "ошибка валидации",
+		English: "validation failed",
+	},
+	errcode.InvalidRequest: {
+		Russian: "неверный запрос",
+		English: "invalid request",
+	},
+	errcode.Unauthenticated: {
+		Russian: "отсутствует токен авторизации",
+		English: "authorization token is missing",
+	},
+	errcode.InvalidToken: {
+		Russian: "невалидный токен",
+		English: "invalid authorization token",
+	},
+	errcode.PermissionDenied: {
+		Russian: "недостаточно прав для выполнения операции",
+		English: "insufficient permissions for this operation",
+	},
+	errcode.RouteNotFound: {
+		Russian: "ресурс не найден",
+		English: "resource not found",
+	},
+	errcode.MethodNotAllowed: {
+		Russian: "метод не поддерживается",
+		English: "method not allowed",
+	},
+	errcode.ShuttingDown: {
+		Russian: "сервер останавливается, повторите запрос позже",
+		English: "server is shutting down, retry later",
+	},
+	cursor.CodeInvalid: {
+		Russian: "неверный курсор пагинации",
+		English: "invalid pagination cursor",
+	},
+
+	auth.CodeInvalidRole: {
+		Russian: "неверная роль пользователя",
+		English: "invalid user role",
+	},
+	auth.CodeUserAlreadyExists: {
+		Russian: "пользователь с таким email уже существует",
+		English: "a user with this email already exists",
+	},
+	auth.CodeUserNotFound: {
+		Russian: "пользователь не найден",
+		English: "user not found",
+	},
+	auth.CodeInvalidCredentials: {
+		Russian: "неверный email или пароль",
+		English: "invalid email or password",
+	},
+	auth.CodeEmailEmpty: {
+		Russian: "email не может быть пустым",
+		English: "email must not be empty",
+	},
+	auth.CodePasswordEmpty: {
+		Russian: "пароль не может быть пустым",
+		English: "password must not be empty",
+	},
+	auth.CodeRoleEmpty: {
+		Russian: "роль не может быть пустой",
+		English: "role must not be empty",
+	},
+
+	event.CodeCursorExpired: {
+		Russian: "курсор событий устарел, необходимо заново загрузить состояние",
+		English: "event cursor has expired, reload the current state",
+	},
+	event.CodeSubscriberTooSlow: {
+		Russian: "подписчик не успевает обрабатывать события",
+		English: "subscriber is too slow to process events",
+	},
+
+	product.CodeInvalidProductType: {
+		Russian: "неверный тип товара",
+		English: "invalid product type",
+	},
+	product.CodeNoProductsToDelete: {
+		Russian: "нет товаров для удаления",
+		English: "no products to delete",
+	},
+	product.CodeProductNotFound: {
+		Russian: "товар не найден",
+		English: "product not found",
+	},
+	product.CodeTypeEmpty: {
+		Russian: "тип товара не может быть пустым",
+		English: "product type must not be empty",
+	},
+
+	pvz.CodeInvalidCity: {
+		Russian: "город может быть только Москва, Санкт-Петербург или Казань",
+		English: "city must be one of Москва, Санкт-Петербург or Казань",
+	},
+	pvz.CodePVZNotFound: {
+		Russian: "ПВЗ не найден",
+		English: "pickup point not found",
+	},
+	pvz.CodeInvalidPaginationParams: {
+		Russian: "неверные параметры пагинации",
+		English: "invalid pagination parameters",
+	},
+	pvz.CodeCityEmpty: {
+		Russian: "город не может быть пустым",
+		English: "city must not be empty",
+	},
+
+	reception.CodeReceptionAlreadyOpen: {
+		Russian: "уже есть незакрытая приемка",
+		English: "there is already an open reception",
+	},
+	reception.CodeNoActiveReception: {
+		Russian: "нет активной приемки",
+		English: "there is no open reception",
+	},
+	reception.CodeReceptionNotFound: {
+		Russian: "приемка не найдена",
+		English: "reception not found",
+	},
+	reception.CodeReceptionClosed: {
+		Russian: "приемка уже закрыта",
+		English: "reception is already closed",
+	},
+}
+
+// Message возвращает сообщение для кода ошибки на языке lang.
+// Исходные сообщения написаны на русском, поэтому для Default возвращается detail.Text как есть:
+// он может быть подробнее общего сообщения каталога. Для других языков сначала ищется перевод
+// по ключу detail.Key, затем берется сообщение каталога. Если перевода нет, возвращается detail.Text.
+func Message(lang Language, code errcode.Code, detail errcode.Detail) string {
+	if lang == Default && detail.Text != "" {
+		return detail.Text
+	}
+
+	if template, ok := details[detail.Key][lang]; ok {
+		return errcode.Format(template, detail.Params)
+	}
+
+	if text, ok := catalog[code][lang]; ok {
+		return text
+	}
+
+	if detail.Text != "" {
+		return detail.Text
+	}
+
+	return catalog[code][Default]
+}
@@ -0,0 +1,33 @@
+package i18n
+
+import (
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/product"
+	"avito/internal/domain/pvz"
+)
+
+// details содержит переводы подробных сообщений по стабильным ключам. Русский текст берется из самой ошибки,
+// параметры записываются как {name} и подставляются из errcode.Detail.Params.
+var details = map[errcode.DetailKey]map[Language]string{
+	errcode.DetailMalformedBody:      {English: "malformed request body"},
+	errcode.DetailInvalidParams:      {English: "invalid request parameters"},
+	errcode.DetailMissingParam:       {English: "missing required parameter {name}"},
+	errcode.DetailInvalidParamFormat: {English: "invalid format of parameter {name}"},
+	errcode.DetailTooManyParamValues: {English: "too many values for parameter {name}"},
+	errcode.DetailSpecViolation:      {English: "request does not match the API specification"},
+
+	pvz.DetailInvalidPVZID:              {English: "invalid pickup point UUID"},
+	pvz.DetailInvalidReceptionID:        {English: "invalid reception UUID"},
+	pvz.DetailUnknownSortField:          {English: "unknown sort field"},
+	pvz.DetailInvalidReceptionStatus:    {English: "invalid reception status"},
+	pvz.DetailUnknownInclude:            {English: "unknown include value: {value}"},
+	pvz.DetailIncludeNoneCombined:       {English: "include=none cannot be combined with other values"},
+	pvz.DetailProductsWithoutReceptions: {English: "products can only be included together with receptions"},
+	pvz.DetailInvalidRegistrationRange:  {English: "registration date range starts after it ends"},
+	pvz.DetailEmptyIDs:                  {English: "pickup point ID list must not be empty"},
+	pvz.DetailTooManyIDs:                {English: "at most {max} pickup points can be requested"},
+
+	product.DetailScanStartExpected:  {English: "the first message must be a start command"},
+	product.DetailScanAlreadyStarted: {English: "scan session is already open"},
+	product.DetailUnknownScanCommand: {English: "unknown scan command"},
+}
@@ -0,0 +1,199 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"avito/internal/domain/auth"
+	"avito/internal/domain/cursor"
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/event"
+	"avito/internal/domain/product"
+	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
+	"avito/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       i18n.Language
+	}{
+		{name: "Пустой заголовок", acceptLanguage: "", expected: i18n.Russian},
+		{name: "Русский", acceptLanguage: "ru-RU", expected: i18n.Russian},
+		{name: "Английский с регионом", acceptLanguage: "en-US", expected: i18n.English},
+		{name: "Неподдерживаемый язык", acceptLanguage: "fr", expected: i18n.Russian},
+		{name: "Выбор по весам", acceptLanguage: "de;q=0.9, en;q=0.8, ru;q=0.1", expected: i18n.English},
+		{name: "Любой язык", acceptLanguage: "*", expected: i18n.Russian},
+		{name: "Неразборчивый заголовок", acceptLanguage: ";;;q=abc", expected: i18n.Russian},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, i18n.Negotiate(tt.acceptLanguage))
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, i18n.Default, i18n.FromContext(context.Background()))
+
+	ctx := i18n.NewContext(context.Background(), i18n.English)
+	assert.Equal(t, i18n.English, i18n.FromContext(ctx))
+}
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		lang     i18n.Language
+		code     errcode.Code
+		detail   errcode.Detail
+		expected string
+	}{
+		{
+			name:     "Русский сохраняет исходный текст",
+			lang:     i18n.Russian,
+			code:     errcode.ValidationFailed,
+			detail:   errcode.Detail{Key: pvz.DetailInvalidPVZID, Text: "неверный формат UUID ПВЗ"},
+			expected: "неверный формат UUID ПВЗ",
+		},
+		{
+			name:     "Английский берется из каталога",
+			lang:     i18n.English,
+			code:     pvz.CodePVZNotFound,
+			detail:   errcode.Detail{Text: "ПВЗ не найден"},
+			expected: "pickup point not found",
+		},
+		{
+			name:     "Подробности общей ошибки валидации переводятся по ключу",
+			lang:     i18n.English,
+			code:     errcode.ValidationFailed,
+			detail:   errcode.Detail{Key: pvz.DetailInvalidReceptionID, Text: "неверный формат UUID приемки"},
+			expected: "invalid reception UUID",
+		},
+		{
+			name:     "Перевод не зависит от русского текста",
+			lang:     i18n.English,
+			code:     errcode.ValidationFailed,
+			detail:   errcode.Detail{Key: pvz.DetailUnknownSortField, Text: "поле сортировки не поддерживается"},
+			expected: "unknown sort field",
+		},
+		{
+			name: "Параметры подставляются в перевод",
+			lang: i18n.English,
+			code: errcode.ValidationFailed,
+			detail: errcode.Detail{
+				Key:    pvz.DetailTooManyIDs,
+				Params: map[string]string{"max": "100"},
+				Text:   "можно запросить не более 100 ПВЗ",
+			},
+			expected: "at most 100 pickup points can be requested",
+		},
+		{
+			name: "Имя параметра запроса подставляется в перевод",
+			lang: i18n.English,
+			code: errcode.InvalidRequest,
+			detail: errcode.Detail{
+				Key:    errcode.DetailMissingParam,
+				Params: map[string]string{"name": "pvzId"},
+				Text:   "не передан параметр pvzId",
+			},
+			expected: "missing required parameter pvzId",
+		},
+		{
+			name:     "Подробности без ключа заменяются общим сообщением",
+			lang:     i18n.English,
+			code:     errcode.ValidationFailed,
+			detail:   errcode.Detail{Text: "что-то не так с запросом"},
+			expected: "validation failed",
+		},
+		{
+			name:     "Неизвестный код оставляет исходный текст",
+			lang:     i18n.English,
+			code:     "UNKNOWN_CODE",
+			detail:   errcode.Detail{Text: "что-то пошло не так"},
+			expected: "что-то пошло не так",
+		},
+		{
+			name:     "Пустой текст заменяется каталогом",
+			lang:     i18n.Russian,
+			code:     errcode.Internal,
+			expected: "внутренняя ошибка сервера",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, i18n.Message(tt.lang, tt.code, tt.detail))
+		})
+	}
+}
+
+func TestMessage_DetailsAreComplete(t *testing.T) {
+	var detailed []errcode.Detailer
+
+	for _, key := range []errcode.DetailKey{
+		pvz.DetailInvalidPVZID, pvz.DetailInvalidReceptionID, pvz.DetailUnknownSortField,
+		pvz.DetailInvalidReceptionStatus, pvz.DetailUnknownInclude, pvz.DetailIncludeNoneCombined,
+		pvz.DetailProductsWithoutReceptions, pvz.DetailInvalidRegistrationRange, pvz.DetailEmptyIDs, pvz.DetailTooManyIDs,
+	} {
+		detailed = append(detailed, pvz.ValidationError{Key: key})
+	}
+
+	for _, key := range []errcode.DetailKey{
+		product.DetailScanStartExpected, product.DetailScanAlreadyStarted, product.DetailUnknownScanCommand,
+	} {
+		detailed = append(detailed, product.ValidationError{Key: key})
+	}
+
+	for _, d := range detailed {
+		detail := d.Detail()
+
+		t.Run(string(detail.Key), func(t *testing.T) {
+			english := i18n.Message(i18n.English, errcode.ValidationFailed, detail)
+
+			assert.NotEmpty(t, detail.Text)
+			assert.NotEqual(t, detail.Text, english)
+			assert.NotEqual(t, "validation failed", english)
+		})
+	}
+
+	for _, key := range []errcode.DetailKey{
+		errcode.DetailMalformedBody, errcode.DetailInvalidParams, errcode.DetailMissingParam,
+		errcode.DetailInvalidParamFormat, errcode.DetailTooManyParamValues, errcode.DetailSpecViolation,
+	} {
+		t.Run(string(key), func(t *testing.T) {
+			english := i18n.Message(i18n.English, errcode.InvalidRequest, errcode.Detail{Key: key})
+
+			assert.NotEqual(t, "invalid request", english)
+		})
+	}
+}
+
+func TestMessage_CatalogIsComplete(t *testing.T) {
+	codes := []errcode.Code{
+		errcode.Internal, errcode.ValidationFailed, errcode.InvalidRequest, errcode.Unauthenticated,
+		errcode.InvalidToken, errcode.PermissionDenied, errcode.RouteNotFound, errcode.MethodNotAllowed, cursor.CodeInvalid,
+		auth.CodeInvalidRole, auth.CodeUserAlreadyExists, auth.CodeUserNotFound, auth.CodeInvalidCredentials,
+		auth.CodeEmailEmpty, auth.CodePasswordEmpty, auth.CodeRoleEmpty,
+		event.CodeCursorExpired, event.CodeSubscriberTooSlow,
+		product.CodeInvalidProductType, product.CodeNoProductsToDelete, product.CodeProductNotFound, product.CodeTypeEmpty,
+		pvz.CodeInvalidCity, pvz.CodePVZNotFound, pvz.CodeInvalidPaginationParams, pvz.CodeCityEmpty,
+		reception.CodeReceptionAlreadyOpen, reception.CodeNoActiveReception, reception.CodeReceptionNotFound,
+		reception.CodeReceptionClosed,
+	}
+
+	for _, code := range codes {
+		t.Run(string(code), func(t *testing.T) {
+			russian := i18n.Message(i18n.Russian, code, errcode.Detail{})
+			english := i18n.Message(i18n.English, code, errcode.Detail{})
+
+			assert.NotEmpty(t, russian)
+			assert.NotEmpty(t, english)
+			assert.NotEqual(t, russian, english)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Package i18n выбирает язык ответа по Accept-Language и переводит сообщения об ошибках по их кодам.
+package i18n
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+)
+
+// Language - язык сообщений, которые видит клиент.
+type Language string
+
+const (
+	Russian Language = "ru"
+	English Language = "en"
+)
+
+// Default - язык, на котором написаны исходные сообщения. Он же используется, если клиент не указал
+// поддерживаемый язык.
+const Default = Russian
+
+// languages и supported перечислены в одном порядке; первый элемент - язык по умолчанию для matcher.
+var (
+	languages = []Language{Russian, English}
+	supported = []language.Tag{language.Russian, language.English}
+	matcher   = language.NewMatcher(supported)
+)
+
+// Negotiate выбирает язык по значению заголовка Accept-Language с учетом весов q.
+// Пустой или неразборчивый заголовок и неподдерживаемые языки дают Default.
+func Negotiate(acceptLanguage string) Language {
+	if acceptLanguage == "" {
+		return Default
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return Default
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return Default
+	}
+
+	return languages[index]
+}
+
+type contextKey struct{}
+
+// NewContext возвращает контекст с выбранным языком.
+func NewContext(ctx context.Context, lang Language) context.Context {
+	return context.WithValue(ctx, contextKey{}, lang)
+}
+
+// FromContext возвращает язык из контекста или Default.
+func FromContext(ctx context.Context) Language {
+	if lang, ok := ctx.Value(contextKey{}).(Language); ok {
+		return lang
+	}
+
+	return Default
+}
@@ -6,11 +6,11 @@ import (
 	"fmt"
 
 	domainAuth "avito/internal/domain/auth"
+	"avito/internal/infrastructure/pgerr"
 	"avito/pkg/txs"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -35,8 +35,7 @@ func (r *Repository) CreateUser(ctx context.Context, email, passwordHash string,
     `, email, passwordHash, role).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.Role)
 
 	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		if pgerr.IsUniqueViolation(err, "") {
 			return nil, &domainAuth.ErrUserAlreadyExists{}
 		}
 
@@ -0,0 +1,157 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"avito/internal/domain/event"
+)
+
+const (
+	DefaultHistorySize    = 1024
+	DefaultSubscriberSize = 256
+)
+
+// Broker хранит последние события в памяти процесса и раздает их подписчикам.
+// История позволяет подписчику продолжить чтение с курсора после переподключения.
+type Broker struct {
+	mu             sync.Mutex
+	history        []event.Event
+	historySize    int
+	subscriberSize int
+	lastSequence   uint64
+	nextSubID      uint64
+	subscribers    map[uint64]*Subscription
+}
+
+func NewBroker(historySize, subscriberSize int) *Broker {
+	if historySize <= 0 {
+		historySize = DefaultHistorySize
+	}
+
+	if subscriberSize <= 0 {
+		subscriberSize = DefaultSubscriberSize
+	}
+
+	return &Broker{
+		history:        make([]event.Event, 0, historySize),
+		historySize:    historySize,
+		subscriberSize: subscriberSize,
+		subscribers:    make(map[uint64]*Subscription),
+	}
+}
+
+// Publish назначает событию порядковый номер и рассылает его подписчикам.
+// Подписчик с переполненным буфером отключается, чтобы не блокировать сервисы.
+func (b *Broker) Publish(_ context.Context, e event.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastSequence++
+	e.Sequence = b.lastSequence
+
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = time.Now()
+	}
+
+	if len(b.history) == b.historySize {
+		copy(b.history, b.history[1:])
+		b.history = b.history[:len(b.history)-1]
+	}
+
+	b.history = append(b.history, e)
+
+	for id, sub := range b.subscribers {
+		select {
+		case sub.events <- e:
+		default:
+			sub.closeWithError(&event.ErrSubscriberTooSlow{})
+			delete(b.subscribers, id)
+		}
+	}
+}
+
+// Subscribe возвращает подписку на события с номером больше afterSequence.
+// Нулевой курсор означает подписку только на новые события.
+func (b *Broker) Subscribe(afterSequence uint64) (*Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []event.Event
+
+	if afterSequence != 0 {
+		if afterSequence > b.lastSequence {
+			return nil, &event.ErrCursorExpired{}
+		}
+
+		if len(b.history) > 0 && afterSequence+1 < b.history[0].Sequence {
+			return nil, &event.ErrCursorExpired{}
+		}
+
+		for _, e := range b.history {
+			if e.Sequence > afterSequence {
+				replay = append(replay, e)
+			}
+		}
+	}
+
+	b.nextSubID++
+
+	sub := &Subscription{
+		id:     b.nextSubID,
+		broker: b,
+		events: make(chan event.Event, len(replay)+b.subscriberSize),
+		done:   make(chan struct{}),
+	}
+
+	for _, e := range replay {
+		sub.events <- e
+	}
+
+	b.subscribers[sub.id] = sub
+
+	return sub, nil
+}
+
+func (b *Broker) unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[sub.id]; ok {
+		delete(b.subscribers, sub.id)
+		sub.closeWithError(nil)
+	}
+}
+
+type Subscription struct {
+	id     uint64
+	broker *Broker
+	events chan event.Event
+	done   chan struct{}
+	once   sync.Once
+	err    error
+}
+
+// Events возвращает канал событий. После закрытия подписки канал закрывается,
+// а причина доступна через Err.
+func (s *Subscription) Events() <-chan event.Event {
+	return s.events
+}
+
+func (s *Subscription) Err() error {
+	<-s.done
+	return s.err
+}
+
+func (s *Subscription) Close() {
+	s.broker.unsubscribe(s)
+}
+
+func (s *Subscription) closeWithError(err error) {
+	s.once.Do(func() {
+		s.err = err
+		close(s.events)
+		close(s.done)
+	})
+}
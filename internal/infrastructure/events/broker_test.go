@@ -0,0 +1,114 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+
+	"avito/internal/domain/event"
+	"avito/internal/infrastructure/events"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func publishN(broker *events.Broker, n int) {
+	for i := 0; i < n; i++ {
+		broker.Publish(context.Background(), event.Event{
+			Type:  event.TypeProductAdded,
+			PVZID: uuid.New(),
+		})
+	}
+}
+
+func receiveSequences(t *testing.T, sub *events.Subscription, n int) []uint64 {
+	t.Helper()
+
+	result := make([]uint64, 0, n)
+
+	for i := 0; i < n; i++ {
+		e, ok := <-sub.Events()
+		require.True(t, ok, "канал событий закрыт раньше времени")
+
+		result = append(result, e.Sequence)
+	}
+
+	return result
+}
+
+func TestBroker_SubscribeNewEvents(t *testing.T) {
+	broker := events.NewBroker(10, 10)
+	publishN(broker, 2)
+
+	sub, err := broker.Subscribe(0)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	publishN(broker, 2)
+
+	assert.Equal(t, []uint64{3, 4}, receiveSequences(t, sub, 2))
+}
+
+func TestBroker_SubscribeFromCursor(t *testing.T) {
+	broker := events.NewBroker(10, 10)
+	publishN(broker, 5)
+
+	sub, err := broker.Subscribe(3)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	publishN(broker, 1)
+
+	assert.Equal(t, []uint64{4, 5, 6}, receiveSequences(t, sub, 3))
+}
+
+func TestBroker_SubscribeCursorExpired(t *testing.T) {
+	tests := []struct {
+		name          string
+		afterSequence uint64
+	}{
+		{name: "События вытеснены из истории", afterSequence: 1},
+		{name: "Курсор из будущего", afterSequence: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broker := events.NewBroker(3, 10)
+			publishN(broker, 5)
+
+			sub, err := broker.Subscribe(tt.afterSequence)
+
+			assert.Nil(t, sub)
+			assert.IsType(t, &event.ErrCursorExpired{}, err)
+		})
+	}
+}
+
+func TestBroker_SlowSubscriberDropped(t *testing.T) {
+	broker := events.NewBroker(10, 2)
+
+	sub, err := broker.Subscribe(0)
+	require.NoError(t, err)
+
+	publishN(broker, 3)
+
+	assert.Equal(t, []uint64{1, 2}, receiveSequences(t, sub, 2))
+
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+	assert.IsType(t, &event.ErrSubscriberTooSlow{}, sub.Err())
+}
+
+func TestBroker_Close(t *testing.T) {
+	broker := events.NewBroker(10, 10)
+
+	sub, err := broker.Subscribe(0)
+	require.NoError(t, err)
+
+	sub.Close()
+	publishN(broker, 1)
+
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+	assert.NoError(t, sub.Err())
+}
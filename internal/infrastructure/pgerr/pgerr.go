@@ -0,0 +1,22 @@
+// Package pgerr распознает ошибки PostgreSQL, которые репозитории переводят в доменные ошибки.
+package pgerr
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// CodeUniqueViolation - SQLSTATE нарушения уникального ограничения или индекса.
+const CodeUniqueViolation = "23505"
+
+// IsUniqueViolation сообщает, нарушает ли err уникальное ограничение constraint.
+// Пустой constraint подходит для любого уникального ограничения.
+func IsUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != CodeUniqueViolation {
+		return false
+	}
+
+	return constraint == "" || pgErr.ConstraintName == constraint
+}
@@ -0,0 +1,36 @@
+package pgerr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"avito/internal/infrastructure/pgerr"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	violation := fmt.Errorf("ошибка вставки: %w",
+		&pgconn.PgError{Code: pgerr.CodeUniqueViolation, ConstraintName: "users_email_key"})
+
+	tests := []struct {
+		name       string
+		err        error
+		constraint string
+		expected   bool
+	}{
+		{name: "Любое ограничение", err: violation, expected: true},
+		{name: "Совпадающее ограничение", err: violation, constraint: "users_email_key", expected: true},
+		{name: "Другое ограничение", err: violation, constraint: "idx_reception_pvz_id_in_progress", expected: false},
+		{name: "Другой SQLSTATE", err: &pgconn.PgError{Code: "23503"}, expected: false},
+		{name: "Не ошибка PostgreSQL", err: errors.New("connection refused"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, pgerr.IsUniqueViolation(tt.err, tt.constraint))
+		})
+	}
+}
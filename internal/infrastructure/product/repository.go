@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"avito/internal/domain/product"
+	"avito/internal/domain/reception"
 	"avito/pkg/txs"
 
 	"github.com/google/uuid"
@@ -23,26 +24,25 @@ func NewRepository(pool *pgxpool.Pool) *Repository {
 	}
 }
 
+// AddProduct добавляет товар со следующим номером в приемке. Номер выдается счетчиком в строке приемки:
+// UPDATE блокирует строку до конца транзакции, поэтому параллельные сканирования в одну приемку
+// получают разные номера и выполняются по очереди.
 func (r *Repository) AddProduct(ctx context.Context, productType product.Type, receptionID uuid.UUID) (*product.Product, error) {
 	q := txs.GetQuerier(ctx, r.pool)
 
-	var nextSequence int
-	err := q.QueryRow(ctx, `
-        SELECT COALESCE(MAX(sequence_number), 0) + 1
-        FROM products
-        WHERE reception_id = $1
-    `, receptionID).Scan(&nextSequence)
-
-	if err != nil {
-		return nil, fmt.Errorf("ошибка при получении номера последовательности: %w", err)
-	}
-
 	var productObj product.Product
-	err = q.QueryRow(ctx, `
+	err := q.QueryRow(ctx, `
+        WITH seq AS (
+            UPDATE receptions
+            SET last_product_sequence = last_product_sequence + 1
+            WHERE id = $2
+            RETURNING id, last_product_sequence
+        )
         INSERT INTO products (type, reception_id, sequence_number)
-        VALUES ($1, $2, $3)
+        SELECT $1, id, last_product_sequence
+        FROM seq
         RETURNING id, date_time, type, reception_id, sequence_number
-    `, productType, receptionID, nextSequence).Scan(
+    `, productType, receptionID).Scan(
 		&productObj.ID,
 		&productObj.DateTime,
 		&productObj.Type,
@@ -51,48 +51,75 @@ func (r *Repository) AddProduct(ctx context.Context, productType product.Type, r
 	)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &reception.ErrReceptionNotFound{}
+		}
+
 		return nil, fmt.Errorf("ошибка при добавлении товара: %w", err)
 	}
 
 	return &productObj, nil
 }
 
-func (r *Repository) DeleteLastProduct(ctx context.Context, receptionID uuid.UUID) error {
-	q := txs.GetQuerier(ctx, r.pool)
+// DeleteLastProduct удаляет товар с наибольшим номером и возвращает счетчик приемки назад, чтобы следующий
+// товар получил освободившийся номер. Строка приемки блокируется до поиска последнего товара, иначе
+// параллельное добавление или удаление может оказаться не видно в снимке запроса.
+func (r *Repository) DeleteLastProduct(ctx context.Context, receptionID uuid.UUID) (*product.Product, error) {
+	tx, err := txs.GetQuerier(ctx, r.pool).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при начале транзакции удаления товара: %w", err)
+	}
 
-	var productID uuid.UUID
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
 
-	var sequenceNumber int
-	err := q.QueryRow(ctx, `
-        SELECT id, sequence_number
-        FROM products
-        WHERE reception_id = $1
-        ORDER BY sequence_number DESC
-        LIMIT 1
-    `, receptionID).Scan(&productID, &sequenceNumber)
+	_, err = tx.Exec(ctx, `SELECT 1 FROM receptions WHERE id = $1 FOR UPDATE`, receptionID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при блокировке приемки: %w", err)
+	}
+
+	var deletedProduct product.Product
+	err = tx.QueryRow(ctx, `
+        DELETE FROM products
+        WHERE id = (
+            SELECT id
+            FROM products
+            WHERE reception_id = $1
+            ORDER BY sequence_number DESC
+            LIMIT 1
+        )
+        RETURNING id, date_time, type, reception_id, sequence_number
+    `, receptionID).Scan(
+		&deletedProduct.ID,
+		&deletedProduct.DateTime,
+		&deletedProduct.Type,
+		&deletedProduct.ReceptionID,
+		&deletedProduct.SequenceNumber,
+	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return &product.ErrNoProductsToDelete{}
+			return nil, &product.ErrNoProductsToDelete{}
 		}
 
-		return fmt.Errorf("ошибка при поиске последнего товара: %w", err)
+		return nil, fmt.Errorf("ошибка при удалении товара: %w", err)
 	}
 
-	cmdTag, err := q.Exec(ctx, `
-        DELETE FROM products
+	_, err = tx.Exec(ctx, `
+        UPDATE receptions
+        SET last_product_sequence = $2
         WHERE id = $1
-    `, productID)
-
+    `, receptionID, deletedProduct.SequenceNumber-1)
 	if err != nil {
-		return fmt.Errorf("ошибка при удалении товара: %w", err)
+		return nil, fmt.Errorf("ошибка при обновлении счетчика товаров: %w", err)
 	}
 
-	if cmdTag.RowsAffected() == 0 {
-		return &product.ErrProductNotFound{}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("ошибка при удалении товара: %w", err)
 	}
 
-	return nil
+	return &deletedProduct, nil
 }
 
 func (r *Repository) GetProductsByReceptionID(ctx context.Context, receptionID uuid.UUID) ([]product.Product, error) {
@@ -128,3 +155,50 @@ func (r *Repository) GetProductsByReceptionID(ctx context.Context, receptionID u
 
 	return products, nil
 }
+
+// ListProductsByReceptionID возвращает страницу товаров приемки по порядку добавления.
+// Запрашивается на одну запись больше лимита, чтобы понять, есть ли следующая страница.
+func (r *Repository) ListProductsByReceptionID(ctx context.Context, req product.ListRequest) (*product.Page, error) {
+	q := txs.GetQuerier(ctx, r.pool)
+
+	after := 0
+	if req.After != nil {
+		after = req.After.SequenceNumber
+	}
+
+	rows, err := q.Query(ctx, `
+        SELECT id, date_time, type, reception_id, sequence_number
+        FROM products
+        WHERE reception_id = $1 AND sequence_number > $2
+        ORDER BY sequence_number
+        LIMIT $3
+    `, req.ReceptionID, after, req.Limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении товаров: %w", err)
+	}
+	defer rows.Close()
+
+	var products []product.Product
+
+	for rows.Next() {
+		var prod product.Product
+		if err := rows.Scan(&prod.ID, &prod.DateTime, &prod.Type, &prod.ReceptionID, &prod.SequenceNumber); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании результатов товаров: %w", err)
+		}
+
+		products = append(products, prod)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при обработке результатов товаров: %w", err)
+	}
+
+	page := &product.Page{Items: products}
+
+	if len(products) > req.Limit {
+		page.Items = products[:req.Limit]
+		page.Next = product.CursorAfter(page.Items[req.Limit-1])
+	}
+
+	return page, nil
+}
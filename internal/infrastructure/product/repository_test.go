@@ -0,0 +1,122 @@
+package product_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+
+	"avito/internal/domain/product"
+	infraProduct "avito/internal/infrastructure/product"
+	"avito/internal/testutil/testdb"
+	"avito/pkg/txs"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	scanWorkers       = 16
+	scansPerWorker    = 25
+	concurrentScanned = scanWorkers * scansPerWorker
+)
+
+// receptionPool подключается к тестовой базе и создает ПВЗ с открытой приемкой.
+func receptionPool(t *testing.T) (*pgxpool.Pool, uuid.UUID) {
+	t.Helper()
+
+	pool := testdb.Pool(t)
+	pvzID := testdb.CreatePVZ(t, pool, "Москва")
+
+	var receptionID uuid.UUID
+
+	err := pool.QueryRow(context.Background(), `
+		INSERT INTO receptions (pvz_id, status) VALUES ($1, 'in_progress') RETURNING id
+	`, pvzID).Scan(&receptionID)
+	require.NoError(t, err)
+
+	return pool, receptionID
+}
+
+// hammer запускает scanWorkers горутин, каждая вызывает fn scansPerWorker раз, и собирает результаты.
+// Половина вызовов идет внутри транзакции, как в сервисе, половина - без нее.
+func hammer(t *testing.T, txManager *txs.TxManager, fn func(ctx context.Context) (*product.Product, error)) []int {
+	t.Helper()
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		sequences []int
+	)
+
+	for worker := range scanWorkers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range scansPerWorker {
+				var (
+					prod *product.Product
+					err  error
+				)
+
+				if (worker+i)%2 == 0 {
+					err = txManager.WithTransaction(context.Background(), func(txCtx context.Context) error {
+						prod, err = fn(txCtx)
+						return err
+					})
+				} else {
+					prod, err = fn(context.Background())
+				}
+
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				mu.Lock()
+				sequences = append(sequences, prod.SequenceNumber)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	slices.Sort(sequences)
+
+	return sequences
+}
+
+func TestRepository_ConcurrentScans(t *testing.T) {
+	pool, receptionID := receptionPool(t)
+	repo := infraProduct.NewRepository(pool)
+	txManager := txs.NewTxManager(pool, slog.New(slog.NewTextHandler(io.Discard, nil)), txs.DefaultRetryPolicy())
+	ctx := context.Background()
+
+	expected := make([]int, concurrentScanned)
+	for i := range expected {
+		expected[i] = i + 1
+	}
+
+	added := hammer(t, txManager, func(ctx context.Context) (*product.Product, error) {
+		return repo.AddProduct(ctx, product.TypeShoes, receptionID)
+	})
+	assert.Equal(t, expected, added, "номера товаров должны быть уникальны и идти подряд")
+
+	deleted := hammer(t, txManager, func(ctx context.Context) (*product.Product, error) {
+		return repo.DeleteLastProduct(ctx, receptionID)
+	})
+	assert.Equal(t, expected, deleted, "каждый товар должен быть удален ровно один раз")
+
+	products, err := repo.GetProductsByReceptionID(ctx, receptionID)
+	require.NoError(t, err)
+	assert.Empty(t, products)
+
+	prod, err := repo.AddProduct(ctx, product.TypeClothes, receptionID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, prod.SequenceNumber)
+}
@@ -17,13 +17,16 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Repository читает ПВЗ вне транзакций с реплик replicas, если они заданы.
 type Repository struct {
-	pool *pgxpool.Pool
+	pool     *pgxpool.Pool
+	replicas *txs.ReplicaSet
 }
 
-func NewRepository(pool *pgxpool.Pool) *Repository {
+func NewRepository(pool *pgxpool.Pool, replicas *txs.ReplicaSet) *Repository {
 	return &Repository{
-		pool: pool,
+		pool:     pool,
+		replicas: replicas,
 	}
 }
 
@@ -45,7 +48,7 @@ func (r *Repository) CreatePVZ(ctx context.Context, city pvz.City) (*pvz.PVZ, er
 }
 
 func (r *Repository) GetPVZByID(ctx context.Context, id uuid.UUID) (*pvz.PVZ, error) {
-	q := txs.GetQuerier(ctx, r.pool)
+	q := txs.GetReadQuerier(ctx, r.pool, r.replicas)
 
 	var pvzObj pvz.PVZ
 	err := q.QueryRow(ctx, `
@@ -65,69 +68,256 @@ func (r *Repository) GetPVZByID(ctx context.Context, id uuid.UUID) (*pvz.PVZ, er
 	return &pvzObj, nil
 }
 
-//nolint:funlen // сложный SQL-конструктор, разбиение ухудшит читаемость и поддержку кода
-func (r *Repository) GetPVZs(ctx context.Context, startDate, endDate *time.Time, city *pvz.City,
-	page, limit int) ([]pvz.WithReceptions, error) {
-	q := txs.GetQuerier(ctx, r.pool)
+func (r *Repository) GetPVZsByIDs(ctx context.Context, ids []uuid.UUID) ([]pvz.WithReceptions, error) {
+	q := txs.GetReadQuerier(ctx, r.pool, r.replicas)
+
+	pvzs, err := queryPVZs(ctx, q, `
+        SELECT id, registration_date, city
+        FROM pvz
+        WHERE id = ANY($1)
+    `, ids)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении ПВЗ по списку ID: %w", err)
+	}
+
+	result, err := withReceptions(ctx, q, pvzs, pvz.GetPVZsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении приемок для ПВЗ: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetPVZs возвращает страницу ПВЗ в порядке req.Sort, при равных значениях поля - по id.
+// Запрашивается на одну запись больше лимита, чтобы понять, есть ли следующая страница.
+// Вложенные приемки и товары загружаются двумя запросами на всю страницу, независимо от ее размера.
+func (r *Repository) GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) (*pvz.Page, error) {
+	q := txs.GetReadQuerier(ctx, r.pool, r.replicas)
+
+	where, args := pvzFilter(req)
 
 	query := `
-		SELECT p.id, p.registration_date, p.city
-		FROM pvz p
+		SELECT p.id, p.registration_date, p.city, p.sort_key
+		FROM (
+			SELECT p.id, p.registration_date, p.city, ` + sortKey(req.Sort.Field) + ` AS sort_key
+			FROM pvz p
+			` + whereClause(where) + `
+		) p
 	`
 
+	direction, comparison := "DESC", "<"
+	if req.Sort.Asc {
+		direction, comparison = "ASC", ">"
+	}
+
+	if req.After != nil {
+		args = append(args, cursorKey(req.After), req.After.ID)
+		query += fmt.Sprintf(" WHERE (p.sort_key, p.id) %s ($%d, $%d)", comparison, len(args)-1, len(args))
+	}
+
+	args = append(args, req.Limit+1, (req.Page-1)*req.Limit)
+	query += fmt.Sprintf(" ORDER BY p.sort_key %s, p.id %s LIMIT $%d OFFSET $%d",
+		direction, direction, len(args)-1, len(args))
+
+	rows, err := queryPVZPage(ctx, q, req.Sort.Field, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении списка ПВЗ: %w", err)
+	}
+
+	page := &pvz.Page{}
+
+	if len(rows) > req.Limit {
+		rows = rows[:req.Limit]
+		last := rows[len(rows)-1]
+		page.Next = &pvz.Cursor{Sort: req.Sort, Time: last.sortTime, Count: last.sortCount, ID: last.pvz.ID}
+	}
+
+	pvzs := make([]pvz.PVZ, 0, len(rows))
+	for _, row := range rows {
+		pvzs = append(pvzs, row.pvz)
+	}
+
+	page.Items, err = withReceptions(ctx, q, pvzs, req)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении приемок для ПВЗ: %w", err)
+	}
+
+	return page, nil
+}
+
+// CountPVZs возвращает количество ПВЗ, подходящих под фильтры запроса, без учета пагинации.
+func (r *Repository) CountPVZs(ctx context.Context, req pvz.GetPVZsRequest) (int, error) {
+	q := txs.GetReadQuerier(ctx, r.pool, r.replicas)
+
+	where, args := pvzFilter(req)
+
+	var total int
+	if err := q.QueryRow(ctx, `SELECT count(*) FROM pvz p `+whereClause(where), args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("ошибка при подсчете ПВЗ: %w", err)
+	}
+
+	return total, nil
+}
+
+// sortKey возвращает SQL-выражение поля сортировки для ПВЗ p.
+func sortKey(field pvz.SortField) string {
+	switch field {
+	case pvz.SortReceptionCount:
+		return `(SELECT count(*) FROM receptions rc WHERE rc.pvz_id = p.id)`
+	case pvz.SortLastActivity:
+		// GREATEST пропускает NULL, поэтому у ПВЗ без приемок и товаров активность равна дате регистрации.
+		return `GREATEST(
+				p.registration_date,
+				(SELECT max(ra.date_time) FROM receptions ra WHERE ra.pvz_id = p.id),
+				(SELECT max(pa.date_time) FROM products pa JOIN receptions ra ON ra.id = pa.reception_id
+					WHERE ra.pvz_id = p.id)
+			)`
+	default:
+		return `p.registration_date`
+	}
+}
+
+// cursorKey возвращает значение поля сортировки из курсора.
+func cursorKey(c *pvz.Cursor) any {
+	if c.Sort.Field == pvz.SortReceptionCount {
+		return c.Count
+	}
+
+	return c.Time
+}
+
+func whereClause(where []string) string {
+	if len(where) == 0 {
+		return ""
+	}
+
+	return "WHERE " + strings.Join(where, " AND ")
+}
+
+// pvzFilter строит условия WHERE по фильтрам запроса для ПВЗ p.
+func pvzFilter(req pvz.GetPVZsRequest) ([]string, []any) {
 	where := []string{}
-	args := []interface{}{}
-	argIndex := 1
+	args := []any{}
 
-	if city != nil {
-		where = append(where, fmt.Sprintf("p.city = $%d", argIndex))
-		args = append(args, *city)
-		argIndex++
+	if len(req.Cities) > 0 {
+		args = append(args, toStrings(req.Cities))
+		where = append(where, fmt.Sprintf("p.city = ANY($%d)", len(args)))
 	}
 
-	if startDate != nil || endDate != nil {
-		subquery := `EXISTS (
-			SELECT 1 FROM receptions r 
-			WHERE r.pvz_id = p.id
-		`
+	if req.RegisteredFrom != nil {
+		args = append(args, req.RegisteredFrom)
+		where = append(where, fmt.Sprintf("p.registration_date >= $%d", len(args)))
+	}
 
-		subqueryConds := []string{}
+	if req.RegisteredTo != nil {
+		args = append(args, req.RegisteredTo)
+		where = append(where, fmt.Sprintf("p.registration_date <= $%d", len(args)))
+	}
+
+	if req.HasOpenReception != nil {
+		args = append(args, reception.StatusInProgress)
+		cond := fmt.Sprintf("EXISTS (SELECT 1 FROM receptions ro WHERE ro.pvz_id = p.id AND ro.status = $%d)", len(args))
 
-		if startDate != nil {
-			subqueryConds = append(subqueryConds, fmt.Sprintf("r.date_time >= $%d", argIndex))
-			args = append(args, startDate)
-			argIndex++
+		if !*req.HasOpenReception {
+			cond = "NOT " + cond
 		}
 
-		if endDate != nil {
-			subqueryConds = append(subqueryConds, fmt.Sprintf("r.date_time <= $%d", argIndex))
-			args = append(args, endDate)
-			argIndex++
+		where = append(where, cond)
+	}
+
+	receptionWhere, args := receptionFilter(req, args)
+	if len(receptionWhere) > 0 {
+		where = append(where, "EXISTS (SELECT 1 FROM receptions r WHERE r.pvz_id = p.id AND "+
+			strings.Join(receptionWhere, " AND ")+")")
+	}
+
+	return where, args
+}
+
+// receptionFilter строит условия на приемку r по фильтрам приемок запроса. Те же условия отбирают ПВЗ
+// и вложенные в них приемки.
+func receptionFilter(req pvz.GetPVZsRequest, args []any) ([]string, []any) {
+	where := []string{}
+
+	if req.StartDate != nil {
+		args = append(args, req.StartDate)
+		where = append(where, fmt.Sprintf("r.date_time >= $%d", len(args)))
+	}
+
+	if req.EndDate != nil {
+		args = append(args, req.EndDate)
+		where = append(where, fmt.Sprintf("r.date_time <= $%d", len(args)))
+	}
+
+	if len(req.ReceptionStatuses) > 0 {
+		args = append(args, toStrings(req.ReceptionStatuses))
+		where = append(where, fmt.Sprintf("r.status = ANY($%d)", len(args)))
+	}
+
+	if len(req.ProductTypes) > 0 {
+		args = append(args, toStrings(req.ProductTypes))
+		where = append(where, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM products pr WHERE pr.reception_id = r.id AND pr.type = ANY($%d))", len(args)))
+	}
+
+	return where, args
+}
+
+func toStrings[T ~string](values []T) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, string(v))
+	}
+
+	return result
+}
+
+// pvzRow - ПВЗ страницы вместе со значением поля сортировки для курсора.
+type pvzRow struct {
+	pvz       pvz.PVZ
+	sortTime  time.Time
+	sortCount int
+}
+
+func queryPVZPage(ctx context.Context, q txs.Querier, field pvz.SortField, query string, args ...any) ([]pvzRow, error) {
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []pvzRow
+
+	for rows.Next() {
+		var row pvzRow
+
+		var key any = &row.sortTime
+		if field == pvz.SortReceptionCount {
+			key = &row.sortCount
 		}
 
-		if len(subqueryConds) > 0 {
-			subquery += " AND " + strings.Join(subqueryConds, " AND ")
+		if err := rows.Scan(&row.pvz.ID, &row.pvz.RegistrationDate, &row.pvz.City, key); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании результатов ПВЗ: %w", err)
 		}
 
-		subquery += ")"
-		where = append(where, subquery)
+		result = append(result, row)
 	}
 
-	if len(where) > 0 {
-		query += " WHERE " + strings.Join(where, " AND ")
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при обработке результатов ПВЗ: %w", err)
 	}
 
-	query += fmt.Sprintf(" ORDER BY p.registration_date DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
-
-	args = append(args, limit, (page-1)*limit)
+	return result, nil
+}
 
+func queryPVZs(ctx context.Context, q txs.Querier, query string, args ...any) ([]pvz.PVZ, error) {
 	rows, err := q.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("ошибка при получении списка ПВЗ: %w", err)
+		return nil, err
 	}
 	defer rows.Close()
 
-	var result []pvz.WithReceptions
+	var pvzs []pvz.PVZ
 
 	for rows.Next() {
 		var pvzObj pvz.PVZ
@@ -135,59 +325,141 @@ func (r *Repository) GetPVZs(ctx context.Context, startDate, endDate *time.Time,
 			return nil, fmt.Errorf("ошибка при сканировании результатов ПВЗ: %w", err)
 		}
 
-		receptions, err := r.getReceptionsWithProductsByPVZID(ctx, pvzObj.ID, startDate, endDate)
-		if err != nil {
-			return nil, fmt.Errorf("ошибка при получении приемок для ПВЗ: %w", err)
-		}
-
-		result = append(result, pvz.WithReceptions{
-			PVZ:        pvzObj,
-			Receptions: receptions,
-		})
+		pvzs = append(pvzs, pvzObj)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("ошибка при обработке результатов ПВЗ: %w", err)
 	}
 
+	return pvzs, nil
+}
+
+// withReceptions дополняет ПВЗ приемками, выбранными фильтрами приемок запроса, и их товарами.
+// Приемки всех ПВЗ загружаются одним запросом, товары всех приемок - другим.
+func withReceptions(ctx context.Context, q txs.Querier, pvzs []pvz.PVZ,
+	req pvz.GetPVZsRequest) ([]pvz.WithReceptions, error) {
+	nested := req.Nested
+
+	result := make([]pvz.WithReceptions, 0, len(pvzs))
+
+	for _, pvzObj := range pvzs {
+		result = append(result, pvz.WithReceptions{PVZ: pvzObj})
+	}
+
+	if nested.OmitReceptions || len(pvzs) == 0 {
+		return result, nil
+	}
+
+	pvzIDs := make([]uuid.UUID, 0, len(pvzs))
+	for _, pvzObj := range pvzs {
+		pvzIDs = append(pvzIDs, pvzObj.ID)
+	}
+
+	receptions, err := queryReceptionsByPVZIDs(ctx, q, pvzIDs, req)
+	if err != nil {
+		return nil, err
+	}
+
+	byPVZ := make(map[uuid.UUID]*pvz.WithReceptions, len(result))
+	for i := range result {
+		byPVZ[result[i].PVZ.ID] = &result[i]
+	}
+
+	receptionIDs := make([]uuid.UUID, 0, len(receptions))
+
+	for _, rec := range receptions {
+		item := byPVZ[rec.PVZID]
+
+		if nested.ReceptionsLimit > 0 && len(item.Receptions) == nested.ReceptionsLimit {
+			item.HasMoreReceptions = true
+			continue
+		}
+
+		item.Receptions = append(item.Receptions, pvz.ReceptionWithItems{Reception: rec})
+		receptionIDs = append(receptionIDs, rec.ID)
+	}
+
+	if nested.OmitProducts || len(receptionIDs) == 0 {
+		return result, nil
+	}
+
+	if err := attachProducts(ctx, q, result, receptionIDs, nested.ProductsLimit); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
-func (r *Repository) getReceptionsWithProductsByPVZID(ctx context.Context, pvzID uuid.UUID, startDate,
-	endDate *time.Time) ([]pvz.ReceptionWithItems, error) {
-	q := txs.GetQuerier(ctx, r.pool)
+// attachProducts загружает товары приемок одним запросом и раскладывает их по приемкам.
+func attachProducts(ctx context.Context, q txs.Querier, items []pvz.WithReceptions, receptionIDs []uuid.UUID,
+	limit int) error {
+	products, err := queryProductsByReceptionIDs(ctx, q, receptionIDs, limit)
+	if err != nil {
+		return err
+	}
+
+	for i := range items {
+		for j := range items[i].Receptions {
+			rec := &items[i].Receptions[j]
+			rec.Products = products[rec.Reception.ID]
+
+			if limit > 0 && len(rec.Products) > limit {
+				rec.Products = rec.Products[:limit]
+				rec.HasMoreProducts = true
+			}
+		}
+	}
+
+	return nil
+}
+
+// queryReceptionsByPVZIDs возвращает выбранные фильтрами приемки ПВЗ, сгруппированные по ПВЗ,
+// внутри группы от новых к старым. При Nested.ReceptionsLimit > 0 на каждый ПВЗ возвращается
+// не больше ReceptionsLimit+1 приемок: лишняя показывает, что приемки обрезаны.
+func queryReceptionsByPVZIDs(ctx context.Context, q txs.Querier, pvzIDs []uuid.UUID,
+	req pvz.GetPVZsRequest) ([]reception.Reception, error) {
+	where, args := receptionFilter(req, []any{pvzIDs})
+	where = append([]string{"r.pvz_id = ANY($1)"}, where...)
 
 	query := `
         SELECT r.id, r.date_time, r.pvz_id, r.status
         FROM receptions r
-        WHERE r.pvz_id = $1
+        WHERE ` + strings.Join(where, " AND ") + `
+        ORDER BY r.pvz_id, r.date_time DESC, r.id DESC
     `
 
-	args := []interface{}{pvzID}
-	argIndex := 2
-
-	if startDate != nil {
-		query += fmt.Sprintf(" AND r.date_time >= $%d", argIndex)
-
-		args = append(args, startDate)
-		argIndex++
+	if limit := req.Nested.ReceptionsLimit; limit > 0 {
+		args = append(args, limit+1)
+		query = fmt.Sprintf(`
+        SELECT id, date_time, pvz_id, status
+        FROM (
+            SELECT r.id, r.date_time, r.pvz_id, r.status,
+                row_number() OVER (PARTITION BY r.pvz_id ORDER BY r.date_time DESC, r.id DESC) AS rn
+            FROM receptions r
+            WHERE %s
+        ) r
+        WHERE rn <= $%d
+        ORDER BY pvz_id, rn
+    `, strings.Join(where, " AND "), len(args))
 	}
 
-	if endDate != nil {
-		query += fmt.Sprintf(" AND r.date_time <= $%d", argIndex)
-
-		args = append(args, endDate)
+	receptions, err := queryReceptions(ctx, q, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении приемок: %w", err)
 	}
 
-	query += " ORDER BY r.date_time DESC"
+	return receptions, nil
+}
 
+func queryReceptions(ctx context.Context, q txs.Querier, query string, args ...any) ([]reception.Reception, error) {
 	rows, err := q.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("ошибка при получении приемок: %w", err)
+		return nil, err
 	}
 	defer rows.Close()
 
-	var result []pvz.ReceptionWithItems
+	var receptions []reception.Reception
 
 	for rows.Next() {
 		var rec reception.Reception
@@ -195,41 +467,51 @@ func (r *Repository) getReceptionsWithProductsByPVZID(ctx context.Context, pvzID
 			return nil, fmt.Errorf("ошибка при сканировании результатов приемок: %w", err)
 		}
 
-		products, err := r.getProductsByReceptionID(ctx, rec.ID)
-		if err != nil {
-			return nil, fmt.Errorf("ошибка при получении товаров для приемки: %w", err)
-		}
-
-		result = append(result, pvz.ReceptionWithItems{
-			Reception: rec,
-			Products:  products,
-		})
+		receptions = append(receptions, rec)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("ошибка при обработке результатов приемок: %w", err)
 	}
 
-	return result, nil
+	return receptions, nil
 }
 
-func (r *Repository) getProductsByReceptionID(ctx context.Context, receptionID uuid.UUID) ([]product.Product, error) {
-	q := txs.GetQuerier(ctx, r.pool)
-
+// queryProductsByReceptionIDs возвращает товары приемок по порядку добавления, сгруппированные по приемке.
+// При limit > 0 на каждую приемку возвращается не больше limit+1 товаров.
+func queryProductsByReceptionIDs(ctx context.Context, q txs.Querier, receptionIDs []uuid.UUID,
+	limit int) (map[uuid.UUID][]product.Product, error) {
 	query := `
         SELECT id, date_time, type, reception_id, sequence_number
         FROM products
-        WHERE reception_id = $1
-        ORDER BY sequence_number
+        WHERE reception_id = ANY($1)
+        ORDER BY reception_id, sequence_number
     `
 
-	rows, err := q.Query(ctx, query, receptionID)
+	args := []any{receptionIDs}
+
+	if limit > 0 {
+		args = append(args, limit+1)
+		query = `
+        SELECT id, date_time, type, reception_id, sequence_number
+        FROM (
+            SELECT id, date_time, type, reception_id, sequence_number,
+                row_number() OVER (PARTITION BY reception_id ORDER BY sequence_number) AS rn
+            FROM products
+            WHERE reception_id = ANY($1)
+        ) p
+        WHERE rn <= $2
+        ORDER BY reception_id, sequence_number
+    `
+	}
+
+	rows, err := q.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при получении товаров: %w", err)
 	}
 	defer rows.Close()
 
-	var products []product.Product
+	products := make(map[uuid.UUID][]product.Product, len(receptionIDs))
 
 	for rows.Next() {
 		var prod product.Product
@@ -237,7 +519,7 @@ func (r *Repository) getProductsByReceptionID(ctx context.Context, receptionID u
 			return nil, fmt.Errorf("ошибка при сканировании результатов товаров: %w", err)
 		}
 
-		products = append(products, prod)
+		products[prod.ReceptionID] = append(products[prod.ReceptionID], prod)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -0,0 +1,321 @@
+package pvz_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"avito/internal/domain/product"
+	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
+	infraPVZ "avito/internal/infrastructure/pvz"
+	"avito/internal/testutil/testdb"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	seedPVZs                 = 30
+	seedReceptionsPerPVZ     = 50
+	seedProductsPerReception = 10
+)
+
+// Данные для тестов создаются в 2100 году, чтобы страница по курсору не захватывала ПВЗ из базы.
+var seedStart = time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// queryCounter считает запросы к базе.
+type queryCounter struct {
+	queries atomic.Int64
+}
+
+func (c *queryCounter) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	c.queries.Add(1)
+
+	return ctx
+}
+
+func (c *queryCounter) TraceQueryEnd(context.Context, *pgx.Conn, pgx.TraceQueryEndData) {}
+
+// seededPool подключается к тестовой базе и создает 30 ПВЗ по 50 приемок с 10 товарами в каждой.
+func seededPool(tb testing.TB) (*pgxpool.Pool, *queryCounter) {
+	tb.Helper()
+
+	ctx := context.Background()
+	counter := &queryCounter{}
+
+	pool := testdb.PoolWithConfig(tb, func(config *pgxpool.Config) {
+		config.ConnConfig.Tracer = counter
+	})
+
+	rows, err := pool.Query(ctx, `
+		INSERT INTO pvz (registration_date, city)
+		SELECT $1::timestamptz + g * interval '1 hour', 'Москва'
+		FROM generate_series(1, $2) g
+		RETURNING id
+	`, seedStart, seedPVZs)
+	require.NoError(tb, err)
+
+	pvzIDs, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	require.NoError(tb, err)
+
+	tb.Cleanup(func() {
+		_, err := pool.Exec(ctx, `
+			DELETE FROM products WHERE reception_id IN (SELECT id FROM receptions WHERE pvz_id = ANY($1))
+		`, pvzIDs)
+		assert.NoError(tb, err)
+
+		_, err = pool.Exec(ctx, `DELETE FROM receptions WHERE pvz_id = ANY($1)`, pvzIDs)
+		assert.NoError(tb, err)
+
+		_, err = pool.Exec(ctx, `DELETE FROM pvz WHERE id = ANY($1)`, pvzIDs)
+		assert.NoError(tb, err)
+	})
+
+	_, err = pool.Exec(ctx, `
+		INSERT INTO receptions (pvz_id, date_time, status)
+		SELECT p.id, $2::timestamptz + g * interval '1 minute', 'close'
+		FROM unnest($1::uuid[]) p(id), generate_series(1, $3) g
+	`, pvzIDs, seedStart, seedReceptionsPerPVZ)
+	require.NoError(tb, err)
+
+	_, err = pool.Exec(ctx, `
+		INSERT INTO products (reception_id, type, sequence_number)
+		SELECT r.id, 'обувь', g
+		FROM receptions r, generate_series(1, $2) g
+		WHERE r.pvz_id = ANY($1)
+	`, pvzIDs, seedProductsPerReception)
+	require.NoError(tb, err)
+
+	return pool, counter
+}
+
+// benchPageSizes - размеры страниц, на которых проверяется, что число запросов не зависит от числа ПВЗ.
+var benchPageSizes = []int{1, 10, seedPVZs}
+
+// seededPageRequest запрашивает первую страницу из limit созданных тестом ПВЗ.
+func seededPageRequest(nested pvz.Nested, limit int) pvz.GetPVZsRequest {
+	return pvz.GetPVZsRequest{
+		Page:   1,
+		Limit:  limit,
+		After:  &pvz.Cursor{Time: seedStart.AddDate(1, 0, 0), ID: uuid.Max},
+		Nested: nested,
+	}
+}
+
+func TestRepository_GetPVZs_Nested(t *testing.T) {
+	pool, counter := seededPool(t)
+	repo := infraPVZ.NewRepository(pool, nil)
+	ctx := context.Background()
+
+	t.Run("Все приемки и товары", func(t *testing.T) {
+		counter.queries.Store(0)
+
+		page, err := repo.GetPVZs(ctx, seededPageRequest(pvz.Nested{}, seedPVZs))
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(3), counter.queries.Load())
+		require.Len(t, page.Items, seedPVZs)
+
+		for _, item := range page.Items {
+			assert.False(t, item.HasMoreReceptions)
+			require.Len(t, item.Receptions, seedReceptionsPerPVZ)
+			assert.True(t, item.Receptions[0].Reception.DateTime.After(item.Receptions[1].Reception.DateTime))
+
+			for _, rec := range item.Receptions {
+				assert.Equal(t, item.PVZ.ID, rec.Reception.PVZID)
+				require.Len(t, rec.Products, seedProductsPerReception)
+				assert.Equal(t, 1, rec.Products[0].SequenceNumber)
+			}
+		}
+	})
+
+	t.Run("Ограничение вложенных данных", func(t *testing.T) {
+		page, err := repo.GetPVZs(ctx, seededPageRequest(pvz.Nested{ReceptionsLimit: 5, ProductsLimit: 3}, seedPVZs))
+		require.NoError(t, err)
+
+		require.Len(t, page.Items, seedPVZs)
+
+		for _, item := range page.Items {
+			assert.True(t, item.HasMoreReceptions)
+			require.Len(t, item.Receptions, 5)
+
+			for _, rec := range item.Receptions {
+				assert.True(t, rec.HasMoreProducts)
+				assert.Len(t, rec.Products, 3)
+			}
+		}
+	})
+
+	t.Run("Без товаров", func(t *testing.T) {
+		counter.queries.Store(0)
+
+		page, err := repo.GetPVZs(ctx, seededPageRequest(pvz.Nested{OmitProducts: true}, seedPVZs))
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), counter.queries.Load())
+		assert.Empty(t, page.Items[0].Receptions[0].Products)
+	})
+}
+
+// TestRepository_GetPVZs_QueryCount проверяет отсутствие N+1: вложенные данные страницы загружаются
+// фиксированным числом запросов при любом числе ПВЗ на странице.
+func TestRepository_GetPVZs_QueryCount(t *testing.T) {
+	pool, counter := seededPool(t)
+	repo := infraPVZ.NewRepository(pool, nil)
+	ctx := context.Background()
+
+	modes := []struct {
+		name    string
+		nested  pvz.Nested
+		queries int64
+	}{
+		{name: "Приемки и товары", nested: pvz.Nested{}, queries: 3},
+		{name: "Ограниченные приемки и товары", nested: pvz.Nested{ReceptionsLimit: 10, ProductsLimit: 5}, queries: 3},
+		{name: "Только приемки", nested: pvz.Nested{OmitProducts: true}, queries: 2},
+		{name: "Без вложенных данных", nested: pvz.Nested{OmitReceptions: true, OmitProducts: true}, queries: 1},
+	}
+
+	for _, mode := range modes {
+		for _, size := range benchPageSizes {
+			t.Run(fmt.Sprintf("%s/%d ПВЗ", mode.name, size), func(t *testing.T) {
+				counter.queries.Store(0)
+
+				page, err := repo.GetPVZs(ctx, seededPageRequest(mode.nested, size))
+				require.NoError(t, err)
+
+				assert.Len(t, page.Items, size)
+				assert.Equal(t, mode.queries, counter.queries.Load())
+			})
+		}
+	}
+}
+
+func TestRepository_GetPVZs_FiltersAndSort(t *testing.T) {
+	pool, _ := seededPool(t)
+	repo := infraPVZ.NewRepository(pool, nil)
+	ctx := context.Background()
+
+	// Ограничение по дате регистрации оставляет только созданные тестом ПВЗ.
+	from := seedStart
+	yes, no := true, false
+
+	filters := []struct {
+		name     string
+		req      pvz.GetPVZsRequest
+		expected int
+	}{
+		{name: "Другой город", req: pvz.GetPVZsRequest{Cities: []pvz.City{pvz.CityKazan, pvz.CitySaintPetersburg}}},
+		{name: "Несколько городов", req: pvz.GetPVZsRequest{Cities: []pvz.City{pvz.CityKazan, pvz.CityMoscow}}, expected: seedPVZs},
+		{
+			name: "Статус и тип товара",
+			req: pvz.GetPVZsRequest{
+				ReceptionStatuses: []reception.Status{reception.StatusClosed},
+				ProductTypes:      []product.Type{product.TypeElectronics, product.TypeShoes},
+			},
+			expected: seedPVZs,
+		},
+		{name: "Нет товаров типа", req: pvz.GetPVZsRequest{ProductTypes: []product.Type{product.TypeClothes}}},
+		{name: "С открытой приемкой", req: pvz.GetPVZsRequest{HasOpenReception: &yes}},
+		{name: "Без открытой приемки", req: pvz.GetPVZsRequest{HasOpenReception: &no}, expected: seedPVZs},
+	}
+
+	for _, tt := range filters {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.RegisteredFrom = &from
+			tt.req.Page = 1
+			tt.req.Limit = pvz.MaxPageSize
+			tt.req.Nested = pvz.Nested{OmitReceptions: true, OmitProducts: true}
+
+			page, err := repo.GetPVZs(ctx, tt.req)
+			require.NoError(t, err)
+			assert.Len(t, page.Items, tt.expected)
+
+			total, err := repo.CountPVZs(ctx, tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, total)
+		})
+	}
+
+	sorts := []pvz.Sort{
+		{Field: pvz.SortRegistrationDate},
+		{Field: pvz.SortRegistrationDate, Asc: true},
+		{Field: pvz.SortReceptionCount},
+		{Field: pvz.SortLastActivity, Asc: true},
+	}
+
+	for _, sort := range sorts {
+		t.Run("Обход курсором "+string(sort.Field), func(t *testing.T) {
+			req := pvz.GetPVZsRequest{
+				RegisteredFrom: &from,
+				Sort:           sort,
+				Page:           1,
+				Limit:          7,
+				Nested:         pvz.Nested{OmitReceptions: true, OmitProducts: true},
+			}
+
+			seen := make(map[uuid.UUID]bool)
+
+			for {
+				page, err := repo.GetPVZs(ctx, req)
+				require.NoError(t, err)
+
+				for _, item := range page.Items {
+					assert.False(t, seen[item.PVZ.ID], "ПВЗ повторился")
+					seen[item.PVZ.ID] = true
+				}
+
+				if page.Next == nil {
+					break
+				}
+
+				assert.Equal(t, sort, page.Next.Sort)
+				req.After = page.Next
+			}
+
+			assert.Len(t, seen, seedPVZs)
+		})
+	}
+}
+
+// BenchmarkRepository_GetPVZs загружает страницы из 1, 10 и 30 ПВЗ по 50 приемок с 10 товарами в каждой.
+// Метрика queries/op показывает число запросов к базе на одну страницу; при загрузке по одному ПВЗ
+// она росла бы вместе с размером страницы, а пакетная загрузка держит ее постоянной.
+func BenchmarkRepository_GetPVZs(b *testing.B) {
+	pool, counter := seededPool(b)
+	repo := infraPVZ.NewRepository(pool, nil)
+	ctx := context.Background()
+
+	benchmarks := []struct {
+		name   string
+		nested pvz.Nested
+	}{
+		{name: "all", nested: pvz.Nested{}},
+		{name: "limited", nested: pvz.Nested{ReceptionsLimit: 10, ProductsLimit: 5}},
+		{name: "receptions_only", nested: pvz.Nested{OmitProducts: true}},
+	}
+
+	for _, bm := range benchmarks {
+		for _, size := range benchPageSizes {
+			b.Run(fmt.Sprintf("%s/pvzs=%d", bm.name, size), func(b *testing.B) {
+				req := seededPageRequest(bm.nested, size)
+				counter.queries.Store(0)
+
+				b.ResetTimer()
+
+				for range b.N {
+					if _, err := repo.GetPVZs(ctx, req); err != nil {
+						b.Fatal(err)
+					}
+				}
+
+				b.ReportMetric(float64(counter.queries.Load())/float64(b.N), "queries/op")
+			})
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"avito/internal/domain/reception"
+	"avito/internal/infrastructure/pgerr"
 	"avito/pkg/txs"
 
 	"github.com/google/uuid"
@@ -23,26 +24,26 @@ func NewRepository(pool *pgxpool.Pool) *Repository {
 	}
 }
 
+// activeReceptionIndex - частичный уникальный индекс, допускающий одну незакрытую приемку на ПВЗ.
+const activeReceptionIndex = "idx_reception_pvz_id_in_progress"
+
+// CreateReception открывает приемку в ПВЗ. Единственность незакрытой приемки гарантирует индекс
+// activeReceptionIndex: из параллельных запросов на один ПВЗ успешен только первый.
 func (r *Repository) CreateReception(ctx context.Context, pvzID uuid.UUID) (*reception.Reception, error) {
 	q := txs.GetQuerier(ctx, r.pool)
 
-	existingReception, err := r.GetActiveReceptionByPVZID(ctx, pvzID)
-	if err != nil && !errors.Is(err, &reception.ErrNoActiveReception{}) {
-		return nil, fmt.Errorf("ошибка при проверке активной приемки: %w", err)
-	}
-
-	if existingReception != nil {
-		return nil, &reception.ErrActiveReceptionExists{}
-	}
-
 	var receptionObj reception.Reception
-	err = q.QueryRow(ctx, `
+	err := q.QueryRow(ctx, `
         INSERT INTO receptions (pvz_id, status)
         VALUES ($1, $2)
         RETURNING id, date_time, pvz_id, status
     `, pvzID, reception.StatusInProgress).Scan(&receptionObj.ID, &receptionObj.DateTime, &receptionObj.PVZID, &receptionObj.Status)
 
 	if err != nil {
+		if pgerr.IsUniqueViolation(err, activeReceptionIndex) {
+			return nil, &reception.ErrActiveReceptionExists{}
+		}
+
 		return nil, fmt.Errorf("ошибка при создании приемки: %w", err)
 	}
 
@@ -119,3 +120,56 @@ func (r *Repository) CloseReception(ctx context.Context, id uuid.UUID) (*recepti
 
 	return &updatedReception, nil
 }
+
+// ListReceptionsByPVZID возвращает страницу приемок ПВЗ в порядке убывания (date_time, id).
+// Запрашивается на одну запись больше лимита, чтобы понять, есть ли следующая страница.
+func (r *Repository) ListReceptionsByPVZID(ctx context.Context, req reception.ListRequest) (*reception.Page, error) {
+	q := txs.GetQuerier(ctx, r.pool)
+
+	query := `
+        SELECT id, date_time, pvz_id, status
+        FROM receptions
+        WHERE pvz_id = $1
+    `
+
+	args := []any{req.PVZID}
+
+	if req.After != nil {
+		query += " AND (date_time, id) < ($2, $3)"
+
+		args = append(args, req.After.DateTime, req.After.ID)
+	}
+
+	args = append(args, req.Limit+1)
+	query += fmt.Sprintf(" ORDER BY date_time DESC, id DESC LIMIT $%d", len(args))
+
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении приемок: %w", err)
+	}
+	defer rows.Close()
+
+	var receptions []reception.Reception
+
+	for rows.Next() {
+		var receptionObj reception.Reception
+		if err := rows.Scan(&receptionObj.ID, &receptionObj.DateTime, &receptionObj.PVZID, &receptionObj.Status); err != nil {
+			return nil, fmt.Errorf("ошибка при сканировании результатов приемок: %w", err)
+		}
+
+		receptions = append(receptions, receptionObj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при обработке результатов приемок: %w", err)
+	}
+
+	page := &reception.Page{Items: receptions}
+
+	if len(receptions) > req.Limit {
+		page.Items = receptions[:req.Limit]
+		page.Next = reception.CursorAfter(page.Items[req.Limit-1])
+	}
+
+	return page, nil
+}
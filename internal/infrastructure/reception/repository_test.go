@@ -0,0 +1,73 @@
+package reception_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"avito/internal/domain/reception"
+	infraReception "avito/internal/infrastructure/reception"
+	"avito/internal/testutil/testdb"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const concurrentOpens = 16
+
+// pvzPool подключается к тестовой базе и создает ПВЗ без приемок.
+func pvzPool(t *testing.T) (*pgxpool.Pool, uuid.UUID) {
+	t.Helper()
+
+	pool := testdb.Pool(t)
+
+	return pool, testdb.CreatePVZ(t, pool, "Казань")
+}
+
+func TestRepository_CreateReception_Concurrent(t *testing.T) {
+	pool, pvzID := pvzPool(t)
+	repo := infraReception.NewRepository(pool)
+	ctx := context.Background()
+
+	var (
+		wg      sync.WaitGroup
+		results = make([]error, concurrentOpens)
+	)
+
+	for i := range concurrentOpens {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, results[i] = repo.CreateReception(ctx, pvzID)
+		}()
+	}
+
+	wg.Wait()
+
+	opened := 0
+
+	for _, err := range results {
+		if err == nil {
+			opened++
+			continue
+		}
+
+		var exists *reception.ErrActiveReceptionExists
+		assert.ErrorAs(t, err, &exists)
+	}
+
+	assert.Equal(t, 1, opened, "должна открыться ровно одна приемка")
+
+	active, err := repo.GetActiveReceptionByPVZID(ctx, pvzID)
+	require.NoError(t, err)
+
+	_, err = repo.CloseReception(ctx, active.ID)
+	require.NoError(t, err)
+
+	_, err = repo.CreateReception(ctx, pvzID)
+	assert.NoError(t, err, "после закрытия приемки можно открыть новую")
+}
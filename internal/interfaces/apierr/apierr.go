@@ -0,0 +1,72 @@
+// Package apierr находит в цепочке ошибок доменную ошибку, о которой можно сообщить клиенту API.
+// Транспорты HTTP и gRPC сопоставляют только ее код со своими статусами.
+package apierr
+
+import (
+	"errors"
+
+	"avito/internal/domain/auth"
+	"avito/internal/domain/cursor"
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/event"
+	"avito/internal/domain/product"
+	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
+)
+
+// matchers перечисляют доменные ошибки, текст и код которых отдаются клиентам.
+// Порядок важен только для ошибок, вложенных друг в друга через %w.
+var matchers = []func(err error) (error, bool){
+	as[*pvz.ErrPVZNotFound],
+	as[*reception.ErrReceptionNotFound],
+	as[*product.ErrProductNotFound],
+	as[*auth.ErrUserNotFound],
+
+	as[*reception.ErrActiveReceptionExists],
+	as[*reception.ErrNoActiveReception],
+	as[*reception.ErrReceptionClosed],
+	as[*product.ErrNoProductsToDelete],
+
+	as[*auth.ErrUserAlreadyExists],
+	as[*auth.ErrInvalidCredentials],
+	as[*auth.ErrEmptyToken],
+
+	as[*pvz.ErrInvalidCity],
+	as[*pvz.ErrCityEmpty],
+	as[*pvz.ErrInvalidPaginationParams],
+	as[*cursor.ErrInvalid],
+	as[*product.ErrInvalidProductType],
+	as[*product.ErrTypeEmpty],
+	as[*auth.ErrInvalidRole],
+	as[*auth.ErrEmailEmpty],
+	as[*auth.ErrPasswordEmpty],
+	as[*auth.ErrRoleEmpty],
+	as[*pvz.ValidationError],
+	as[*reception.ValidationError],
+	as[*product.ValidationError],
+	as[*auth.ValidationError],
+
+	as[*event.ErrCursorExpired],
+	as[*event.ErrSubscriberTooSlow],
+}
+
+func as[T error](err error) (error, bool) {
+	var target T
+	if errors.As(err, &target) {
+		return target, true
+	}
+
+	return nil, false
+}
+
+// Find возвращает первую известную доменную ошибку в цепочке err и ее код.
+// Сообщение найденной ошибки не содержит контекста обертки, поэтому его можно отдать клиенту.
+func Find(err error) (error, errcode.Code, bool) {
+	for _, match := range matchers {
+		if domainErr, ok := match(err); ok {
+			return domainErr, errcode.Of(domainErr), true
+		}
+	}
+
+	return nil, errcode.Internal, false
+}
@@ -0,0 +1,66 @@
+package apierr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
+	"avito/internal/interfaces/apierr"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		expectedCode    errcode.Code
+		expectedMessage string
+		expectedOK      bool
+	}{
+		{
+			name:            "Доменная ошибка",
+			err:             &pvz.ErrPVZNotFound{},
+			expectedCode:    pvz.CodePVZNotFound,
+			expectedMessage: "ПВЗ не найден",
+			expectedOK:      true,
+		},
+		{
+			name:            "Обертка не попадает в сообщение",
+			err:             fmt.Errorf("ошибка при создании приемки: %w", &reception.ErrActiveReceptionExists{}),
+			expectedCode:    reception.CodeReceptionAlreadyOpen,
+			expectedMessage: "уже есть незакрытая приемка",
+			expectedOK:      true,
+		},
+		{
+			name:            "Ошибка валидации",
+			err:             &pvz.ValidationError{Key: pvz.DetailInvalidPVZID},
+			expectedCode:    errcode.ValidationFailed,
+			expectedMessage: "неверный формат UUID ПВЗ",
+			expectedOK:      true,
+		},
+		{
+			name:         "Неизвестная ошибка",
+			err:          errors.New("connection refused"),
+			expectedCode: errcode.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domainErr, code, ok := apierr.Find(tt.err)
+
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedCode, code)
+
+			if tt.expectedOK {
+				assert.EqualError(t, domainErr, tt.expectedMessage)
+			} else {
+				assert.NoError(t, domainErr)
+			}
+		})
+	}
+}
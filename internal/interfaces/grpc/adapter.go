@@ -3,14 +3,34 @@ package grpc
 import (
 	"context"
 
+	"avito/internal/domain/product"
 	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
 
 	"github.com/google/uuid"
 )
 
 type DomainPVZService interface {
+	CreatePVZ(ctx context.Context, req pvz.CreatePVZRequest) (*pvz.PVZ, error)
 	GetPVZByID(ctx context.Context, id uuid.UUID) (*pvz.PVZ, error)
-	GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) ([]pvz.WithReceptions, error)
+	GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) (*pvz.Page, error)
+	GetPVZWithReceptions(ctx context.Context, id uuid.UUID) (*pvz.WithReceptions, error)
+	GetPVZsByIDs(ctx context.Context, ids []uuid.UUID) ([]pvz.WithReceptions, error)
+}
+
+type DomainReceptionService interface {
+	CreateReception(ctx context.Context, req reception.CreateReceptionRequest) (*reception.Reception, error)
+	CloseReception(ctx context.Context, pvzID uuid.UUID) (*reception.Reception, error)
+	ListReceptions(ctx context.Context, req reception.ListRequest) (*reception.Page, error)
+}
+
+type DomainProductService interface {
+	AddProduct(ctx context.Context, req product.CreateProductRequest) (*product.Product, error)
+	DeleteLastProduct(ctx context.Context, pvzID uuid.UUID) error
+	ListProducts(ctx context.Context, req product.ListRequest) (*product.Page, error)
+	OpenScanSession(ctx context.Context, pvzID uuid.UUID) (*reception.Reception, error)
+	AddProductToReception(ctx context.Context, activeReception *reception.Reception, productType product.Type) (*product.Product, error)
+	DeleteLastProductFromReception(ctx context.Context, activeReception *reception.Reception) (*product.Product, error)
 }
 
 type PVZServiceAdapter struct {
@@ -23,8 +43,14 @@ func NewPVZServiceAdapter(domainService DomainPVZService) *PVZServiceAdapter {
 	}
 }
 
+func (a *PVZServiceAdapter) CreatePVZ(ctx context.Context, city string) (*pvz.PVZ, error) {
+	return a.domainService.CreatePVZ(ctx, pvz.CreatePVZRequest{
+		City: pvz.City(city),
+	})
+}
+
 func (a *PVZServiceAdapter) GetPVZByID(ctx context.Context, id string) (*pvz.PVZ, error) {
-	uuid, err := uuid.Parse(id)
+	uuid, err := parsePVZID(id)
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +58,125 @@ func (a *PVZServiceAdapter) GetPVZByID(ctx context.Context, id string) (*pvz.PVZ
 	return a.domainService.GetPVZByID(ctx, uuid)
 }
 
-func (a *PVZServiceAdapter) GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) ([]pvz.WithReceptions, error) {
+func (a *PVZServiceAdapter) GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) (*pvz.Page, error) {
 	return a.domainService.GetPVZs(ctx, req)
 }
+
+func (a *PVZServiceAdapter) GetPVZWithReceptions(ctx context.Context, id string) (*pvz.WithReceptions, error) {
+	pvzID, err := parsePVZID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.domainService.GetPVZWithReceptions(ctx, pvzID)
+}
+
+func (a *PVZServiceAdapter) GetPVZsByIDs(ctx context.Context, ids []uuid.UUID) ([]pvz.WithReceptions, error) {
+	return a.domainService.GetPVZsByIDs(ctx, ids)
+}
+
+type ReceptionServiceAdapter struct {
+	domainService DomainReceptionService
+}
+
+func NewReceptionServiceAdapter(domainService DomainReceptionService) *ReceptionServiceAdapter {
+	return &ReceptionServiceAdapter{
+		domainService: domainService,
+	}
+}
+
+func (a *ReceptionServiceAdapter) CreateReception(ctx context.Context, pvzID string) (*reception.Reception, error) {
+	id, err := parsePVZID(pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.domainService.CreateReception(ctx, reception.CreateReceptionRequest{
+		PVZID: id,
+	})
+}
+
+func (a *ReceptionServiceAdapter) CloseLastReception(ctx context.Context, pvzID string) (*reception.Reception, error) {
+	id, err := parsePVZID(pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.domainService.CloseReception(ctx, id)
+}
+
+func (a *ReceptionServiceAdapter) ListReceptions(ctx context.Context, req reception.ListRequest) (*reception.Page, error) {
+	return a.domainService.ListReceptions(ctx, req)
+}
+
+type ProductServiceAdapter struct {
+	domainService DomainProductService
+}
+
+func NewProductServiceAdapter(domainService DomainProductService) *ProductServiceAdapter {
+	return &ProductServiceAdapter{
+		domainService: domainService,
+	}
+}
+
+func (a *ProductServiceAdapter) AddProduct(ctx context.Context, pvzID string, productType product.Type) (*product.Product, error) {
+	id, err := parsePVZID(pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.domainService.AddProduct(ctx, product.CreateProductRequest{
+		Type:  productType,
+		PVZID: id,
+	})
+}
+
+func (a *ProductServiceAdapter) DeleteLastProduct(ctx context.Context, pvzID string) error {
+	id, err := parsePVZID(pvzID)
+	if err != nil {
+		return err
+	}
+
+	return a.domainService.DeleteLastProduct(ctx, id)
+}
+
+func (a *ProductServiceAdapter) ListProducts(ctx context.Context, req product.ListRequest) (*product.Page, error) {
+	return a.domainService.ListProducts(ctx, req)
+}
+
+func (a *ProductServiceAdapter) OpenScanSession(ctx context.Context, pvzID string) (*reception.Reception, error) {
+	id, err := parsePVZID(pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.domainService.OpenScanSession(ctx, id)
+}
+
+func (a *ProductServiceAdapter) AddProductToReception(ctx context.Context, activeReception *reception.Reception,
+	productType product.Type) (*product.Product, error) {
+	return a.domainService.AddProductToReception(ctx, activeReception, productType)
+}
+
+func (a *ProductServiceAdapter) DeleteLastProductFromReception(ctx context.Context,
+	activeReception *reception.Reception) (*product.Product, error) {
+	return a.domainService.DeleteLastProductFromReception(ctx, activeReception)
+}
+
+func parsePVZID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, &pvz.ValidationError{Key: pvz.DetailInvalidPVZID}
+	}
+
+	return parsed, nil
+}
+
+func parseReceptionID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, &pvz.ValidationError{Key: pvz.DetailInvalidReceptionID}
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,158 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"avito/internal/domain/auth"
+	"avito/internal/domain/errcode"
+	pbpvz "avito/internal/interfaces/grpc/pb"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+type contextKey string
+
+const (
+	userIDKey   contextKey = "user_id"
+	userRoleKey contextKey = "user_role"
+
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+type TokenParser interface {
+	ParseToken(tokenString string) (uuid.UUID, auth.Role, error)
+}
+
+// methodRoles повторяет ролевую модель HTTP-роутера.
+// Методы PVZService, отсутствующие в таблице, запрещены для всех.
+var methodRoles = map[string][]auth.Role{
+	pbpvz.PVZService_GetPVZList_FullMethodName:         {auth.RoleEmployee, auth.RoleModerator},
+	pbpvz.PVZService_GetPVZ_FullMethodName:             {auth.RoleEmployee, auth.RoleModerator},
+	pbpvz.PVZService_BatchGetPVZ_FullMethodName:        {auth.RoleEmployee, auth.RoleModerator},
+	pbpvz.PVZService_CreatePVZ_FullMethodName:          {auth.RoleModerator},
+	pbpvz.PVZService_CreateReception_FullMethodName:    {auth.RoleEmployee},
+	pbpvz.PVZService_CloseLastReception_FullMethodName: {auth.RoleEmployee},
+	pbpvz.PVZService_ListReceptions_FullMethodName:     {auth.RoleEmployee, auth.RoleModerator},
+	pbpvz.PVZService_AddProduct_FullMethodName:         {auth.RoleEmployee},
+	pbpvz.PVZService_DeleteLastProduct_FullMethodName:  {auth.RoleEmployee},
+	pbpvz.PVZService_ListProducts_FullMethodName:       {auth.RoleEmployee, auth.RoleModerator},
+	pbpvz.PVZService_ScanProducts_FullMethodName:       {auth.RoleEmployee},
+	pbpvz.PVZService_WatchPVZEvents_FullMethodName:     {auth.RoleEmployee, auth.RoleModerator},
+}
+
+// publicServicePrefixes перечисляет служебные сервисы, доступные без токена.
+var publicServicePrefixes = []string{
+	"/grpc.reflection.",
+	"/grpc.health.v1.Health/",
+}
+
+func UserIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	return userID, ok
+}
+
+func UserRoleFromContext(ctx context.Context) (auth.Role, bool) {
+	role, ok := ctx.Value(userRoleKey).(auth.Role)
+	return role, ok
+}
+
+func AuthUnaryInterceptor(tokenParser TokenParser) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		authCtx, err := authorize(ctx, tokenParser, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(authCtx, req)
+	}
+}
+
+func AuthStreamInterceptor(tokenParser TokenParser) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		authCtx, err := authorize(ss.Context(), tokenParser, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: authCtx})
+	}
+}
+
+func authorize(ctx context.Context, tokenParser TokenParser, fullMethod string) (context.Context, error) {
+	if isPublicMethod(fullMethod) {
+		return ctx, nil
+	}
+
+	token, err := tokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, role, err := tokenParser.ParseToken(token)
+	if err != nil {
+		return nil, statusError(codes.Unauthenticated, errcode.InvalidToken, "невалидный токен")
+	}
+
+	if !hasAnyRole(role, methodRoles[fullMethod]) {
+		return nil, statusError(codes.PermissionDenied, errcode.PermissionDenied, "недостаточно прав для выполнения операции")
+	}
+
+	ctx = context.WithValue(ctx, userIDKey, userID)
+	ctx = context.WithValue(ctx, userRoleKey, role)
+
+	return ctx, nil
+}
+
+func tokenFromMetadata(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", statusError(codes.Unauthenticated, errcode.Unauthenticated, "отсутствует токен авторизации")
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 || values[0] == "" {
+		return "", statusError(codes.Unauthenticated, errcode.Unauthenticated, "отсутствует токен авторизации")
+	}
+
+	token, found := strings.CutPrefix(values[0], bearerPrefix)
+	if !found || token == "" {
+		return "", statusError(codes.Unauthenticated, errcode.InvalidToken, "неверный формат токена")
+	}
+
+	return token, nil
+}
+
+func isPublicMethod(fullMethod string) bool {
+	for _, prefix := range publicServicePrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasAnyRole(role auth.Role, allowed []auth.Role) bool {
+	for _, allowedRole := range allowed {
+		if role == allowedRole {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contextServerStream подменяет контекст стрима, чтобы обработчик видел данные пользователя.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,143 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"avito/internal/domain/auth"
+	grpcServer "avito/internal/interfaces/grpc"
+	pbpvz "avito/internal/interfaces/grpc/pb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type stubTokenParser struct {
+	userID uuid.UUID
+	role   auth.Role
+	err    error
+}
+
+func (p *stubTokenParser) ParseToken(_ string) (uuid.UUID, auth.Role, error) {
+	return p.userID, p.role, p.err
+}
+
+func TestAuthUnaryInterceptor(t *testing.T) {
+	userID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		parser        *stubTokenParser
+		expectedCode  codes.Code
+	}{
+		{
+			name:          "Сотрудник получает список ПВЗ",
+			method:        pbpvz.PVZService_GetPVZList_FullMethodName,
+			authorization: "Bearer token",
+			parser:        &stubTokenParser{userID: userID, role: auth.RoleEmployee},
+			expectedCode:  codes.OK,
+		},
+		{
+			name:          "Модератор создает ПВЗ",
+			method:        pbpvz.PVZService_CreatePVZ_FullMethodName,
+			authorization: "Bearer token",
+			parser:        &stubTokenParser{userID: userID, role: auth.RoleModerator},
+			expectedCode:  codes.OK,
+		},
+		{
+			name:          "Сотрудник не может создать ПВЗ",
+			method:        pbpvz.PVZService_CreatePVZ_FullMethodName,
+			authorization: "Bearer token",
+			parser:        &stubTokenParser{userID: userID, role: auth.RoleEmployee},
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "Модератор не может добавить товар",
+			method:        pbpvz.PVZService_AddProduct_FullMethodName,
+			authorization: "Bearer token",
+			parser:        &stubTokenParser{userID: userID, role: auth.RoleModerator},
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "Отсутствует токен",
+			method:        pbpvz.PVZService_GetPVZList_FullMethodName,
+			authorization: "",
+			parser:        &stubTokenParser{userID: userID, role: auth.RoleEmployee},
+			expectedCode:  codes.Unauthenticated,
+		},
+		{
+			name:          "Неверный формат токена",
+			method:        pbpvz.PVZService_GetPVZList_FullMethodName,
+			authorization: "Basic token",
+			parser:        &stubTokenParser{userID: userID, role: auth.RoleEmployee},
+			expectedCode:  codes.Unauthenticated,
+		},
+		{
+			name:          "Невалидный токен",
+			method:        pbpvz.PVZService_GetPVZList_FullMethodName,
+			authorization: "Bearer token",
+			parser:        &stubTokenParser{err: errors.New("невалидный токен")},
+			expectedCode:  codes.Unauthenticated,
+		},
+		{
+			name:          "Неизвестный метод запрещен",
+			method:        "/pvz.v1.PVZService/Unknown",
+			authorization: "Bearer token",
+			parser:        &stubTokenParser{userID: userID, role: auth.RoleModerator},
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "Reflection доступен без токена",
+			method:        "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+			authorization: "",
+			parser:        &stubTokenParser{err: errors.New("не должен вызываться")},
+			expectedCode:  codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			var handlerCtx context.Context
+
+			handler := func(ctx context.Context, _ any) (any, error) {
+				handlerCtx = ctx
+				return "ok", nil
+			}
+
+			interceptor := grpcServer.AuthUnaryInterceptor(tt.parser)
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+
+			if tt.expectedCode != codes.OK {
+				assert.Nil(t, handlerCtx)
+				return
+			}
+
+			require.NotNil(t, handlerCtx)
+
+			if tt.parser.err == nil {
+				gotUserID, ok := grpcServer.UserIDFromContext(handlerCtx)
+				require.True(t, ok)
+				assert.Equal(t, userID, gotUserID)
+
+				gotRole, ok := grpcServer.UserRoleFromContext(handlerCtx)
+				require.True(t, ok)
+				assert.Equal(t, tt.parser.role, gotRole)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package grpc
+
+import (
+	"time"
+
+	domainEvent "avito/internal/domain/event"
+	domainProduct "avito/internal/domain/product"
+	domainPVZ "avito/internal/domain/pvz"
+	domainReception "avito/internal/domain/reception"
+	pbpvz "avito/internal/interfaces/grpc/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func pvzToProto(p *domainPVZ.PVZ) *pbpvz.PVZ {
+	return &pbpvz.PVZ{
+		Id:               p.ID.String(),
+		RegistrationDate: timestampFromTime(p.RegistrationDate),
+		City:             string(p.City),
+	}
+}
+
+func pvzWithReceptionsToProto(item *domainPVZ.WithReceptions) *pbpvz.PVZ {
+	result := pvzToProto(&item.PVZ)
+	result.Receptions = make([]*pbpvz.ReceptionWithProducts, 0, len(item.Receptions))
+
+	for i := range item.Receptions {
+		rec := &item.Receptions[i]
+
+		products := make([]*pbpvz.Product, 0, len(rec.Products))
+		for j := range rec.Products {
+			products = append(products, productToProto(&rec.Products[j]))
+		}
+
+		result.Receptions = append(result.Receptions, &pbpvz.ReceptionWithProducts{
+			Reception:       receptionToProto(&rec.Reception),
+			Products:        products,
+			HasMoreProducts: rec.HasMoreProducts,
+		})
+	}
+
+	result.HasMoreReceptions = item.HasMoreReceptions
+
+	return result
+}
+
+func receptionToProto(r *domainReception.Reception) *pbpvz.Reception {
+	return &pbpvz.Reception{
+		Id:       r.ID.String(),
+		DateTime: timestampFromTime(r.DateTime),
+		PvzId:    r.PVZID.String(),
+		Status:   receptionStatusToProto(r.Status),
+	}
+}
+
+func productToProto(p *domainProduct.Product) *pbpvz.Product {
+	return &pbpvz.Product{
+		Id:          p.ID.String(),
+		DateTime:    timestampFromTime(p.DateTime),
+		Type:        productTypeToProto(p.Type),
+		ReceptionId: p.ReceptionID.String(),
+	}
+}
+
+func receptionStatusToProto(status domainReception.Status) pbpvz.ReceptionStatus {
+	if status == domainReception.StatusClosed {
+		return pbpvz.ReceptionStatus_RECEPTION_STATUS_CLOSED
+	}
+
+	return pbpvz.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+// receptionStatusFromProto, как и productTypeFromProto, возвращает пустой статус для неизвестных значений.
+func receptionStatusFromProto(status pbpvz.ReceptionStatus) domainReception.Status {
+	switch status {
+	case pbpvz.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS:
+		return domainReception.StatusInProgress
+	case pbpvz.ReceptionStatus_RECEPTION_STATUS_CLOSED:
+		return domainReception.StatusClosed
+	default:
+		return ""
+	}
+}
+
+func productTypeToProto(t domainProduct.Type) pbpvz.ProductType {
+	switch t {
+	case domainProduct.TypeElectronics:
+		return pbpvz.ProductType_PRODUCT_TYPE_ELECTRONICS
+	case domainProduct.TypeClothes:
+		return pbpvz.ProductType_PRODUCT_TYPE_CLOTHES
+	case domainProduct.TypeShoes:
+		return pbpvz.ProductType_PRODUCT_TYPE_SHOES
+	default:
+		return pbpvz.ProductType_PRODUCT_TYPE_UNSPECIFIED
+	}
+}
+
+// productTypeFromProto возвращает пустой тип для неизвестных значений,
+// чтобы валидация осталась на стороне доменного сервиса.
+func productTypeFromProto(t pbpvz.ProductType) domainProduct.Type {
+	//nolint:exhaustive // PRODUCT_TYPE_UNSPECIFIED обрабатывается веткой default
+	switch t {
+	case pbpvz.ProductType_PRODUCT_TYPE_ELECTRONICS:
+		return domainProduct.TypeElectronics
+	case pbpvz.ProductType_PRODUCT_TYPE_CLOTHES:
+		return domainProduct.TypeClothes
+	case pbpvz.ProductType_PRODUCT_TYPE_SHOES:
+		return domainProduct.TypeShoes
+	default:
+		return ""
+	}
+}
+
+func eventToProto(e *domainEvent.Event) *pbpvz.PVZEvent {
+	result := &pbpvz.PVZEvent{
+		Sequence:    e.Sequence,
+		Type:        eventTypeToProto(e.Type),
+		OccurredAt:  timestampFromTime(e.OccurredAt),
+		PvzId:       e.PVZID.String(),
+		ReceptionId: e.ReceptionID.String(),
+	}
+
+	if e.Product != nil {
+		result.Product = productToProto(e.Product)
+	}
+
+	return result
+}
+
+func eventTypeToProto(t domainEvent.Type) pbpvz.PVZEventType {
+	switch t {
+	case domainEvent.TypeReceptionCreated:
+		return pbpvz.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CREATED
+	case domainEvent.TypeProductAdded:
+		return pbpvz.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED
+	case domainEvent.TypeProductDeleted:
+		return pbpvz.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_DELETED
+	case domainEvent.TypeReceptionClosed:
+		return pbpvz.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CLOSED
+	default:
+		return pbpvz.PVZEventType_PVZ_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func timestampFromTime(t time.Time) *timestamppb.Timestamp {
+	return timestamppb.New(t)
+}
+
+func timeFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+
+	return &t
+}
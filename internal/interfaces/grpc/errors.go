@@ -0,0 +1,140 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	"avito/internal/domain/auth"
+	"avito/internal/domain/cursor"
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/event"
+	"avito/internal/domain/product"
+	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
+	"avito/internal/interfaces/apierr"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain передается в ErrorInfo.Domain, чтобы клиент мог отличить наши причины от чужих.
+const errorDomain = "pvz.avito"
+
+const internalErrorMessage = "внутренняя ошибка сервера"
+
+// detailKeyMetadata - ключ ErrorInfo.Metadata с ключом подробного сообщения. Остальные записи Metadata
+// содержат значения параметров сообщения.
+const detailKeyMetadata = "detail"
+
+// codeByErrcode сопоставляет коды доменных ошибок из apierr с кодами gRPC. Код доменной ошибки
+// передается клиенту как причина в ErrorInfo.
+var codeByErrcode = map[errcode.Code]codes.Code{
+	pvz.CodePVZNotFound:             codes.NotFound,
+	reception.CodeReceptionNotFound: codes.NotFound,
+	product.CodeProductNotFound:     codes.NotFound,
+	auth.CodeUserNotFound:           codes.NotFound,
+
+	reception.CodeReceptionAlreadyOpen: codes.FailedPrecondition,
+	reception.CodeNoActiveReception:    codes.FailedPrecondition,
+	reception.CodeReceptionClosed:      codes.FailedPrecondition,
+	product.CodeNoProductsToDelete:     codes.FailedPrecondition,
+
+	auth.CodeUserAlreadyExists:  codes.AlreadyExists,
+	auth.CodeInvalidCredentials: codes.Unauthenticated,
+	errcode.Unauthenticated:     codes.Unauthenticated,
+
+	pvz.CodeInvalidCity:             codes.InvalidArgument,
+	pvz.CodeCityEmpty:               codes.InvalidArgument,
+	pvz.CodeInvalidPaginationParams: codes.InvalidArgument,
+	cursor.CodeInvalid:              codes.InvalidArgument,
+	product.CodeInvalidProductType:  codes.InvalidArgument,
+	product.CodeTypeEmpty:           codes.InvalidArgument,
+	auth.CodeInvalidRole:            codes.InvalidArgument,
+	auth.CodeEmailEmpty:             codes.InvalidArgument,
+	auth.CodePasswordEmpty:          codes.InvalidArgument,
+	auth.CodeRoleEmpty:              codes.InvalidArgument,
+	errcode.ValidationFailed:        codes.InvalidArgument,
+
+	event.CodeCursorExpired:     codes.OutOfRange,
+	event.CodeSubscriberTooSlow: codes.ResourceExhausted,
+}
+
+// ErrorUnaryInterceptor переводит ошибки обработчиков в статусы gRPC.
+func ErrorUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+
+		return resp, nil
+	}
+}
+
+// ErrorStreamInterceptor переводит ошибки стриминговых обработчиков в статусы gRPC.
+func ErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return toStatusError(err)
+		}
+
+		return nil
+	}
+}
+
+// toStatusError возвращает статус с кодом и причиной для доменной ошибки.
+// Клиенту уходит сообщение самой доменной ошибки без контекста обертки.
+// Неизвестные ошибки не раскрываются и превращаются в codes.Internal.
+func toStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	return detailedStatusError(mapError(err))
+}
+
+// mapError возвращает код, причину и подробное сообщение для доменной ошибки.
+func mapError(err error) (codes.Code, errcode.Code, errcode.Detail) {
+	if domainErr, reason, ok := apierr.Find(err); ok {
+		if code, ok := codeByErrcode[reason]; ok {
+			return code, reason, errcode.DetailOf(domainErr)
+		}
+	}
+
+	return codes.Internal, errcode.Internal, errcode.Detail{Text: internalErrorMessage}
+}
+
+func statusError(code codes.Code, reason errcode.Code, message string) error {
+	return detailedStatusError(code, reason, errcode.Detail{Text: message})
+}
+
+// detailedStatusError передает ключ и параметры подробного сообщения в ErrorInfo.Metadata,
+// чтобы сообщение статуса можно было перевести без разбора его текста.
+func detailedStatusError(code codes.Code, reason errcode.Code, detail errcode.Detail) error {
+	st := status.New(code, detail.Text)
+
+	var metadata map[string]string
+	if detail.Key != "" {
+		metadata = make(map[string]string, len(detail.Params)+1)
+		maps.Copy(metadata, detail.Params)
+		metadata[detailKeyMetadata] = string(detail.Key)
+	}
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   string(reason),
+		Domain:   errorDomain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -0,0 +1,125 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/event"
+	"avito/internal/domain/product"
+	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
+	grpcServer "avito/internal/interfaces/grpc"
+	pbpvz "avito/internal/interfaces/grpc/pb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name            string
+		handlerErr      error
+		expectedCode    codes.Code
+		expectedReason  errcode.Code
+		expectedMessage string
+	}{
+		{
+			name:            "ПВЗ не найден",
+			handlerErr:      &pvz.ErrPVZNotFound{},
+			expectedCode:    codes.NotFound,
+			expectedReason:  pvz.CodePVZNotFound,
+			expectedMessage: "ПВЗ не найден",
+		},
+		{
+			name:            "Обернутая ошибка активной приемки",
+			handlerErr:      fmt.Errorf("ошибка при создании приемки: %w", &reception.ErrActiveReceptionExists{}),
+			expectedCode:    codes.FailedPrecondition,
+			expectedReason:  reception.CodeReceptionAlreadyOpen,
+			expectedMessage: "уже есть незакрытая приемка",
+		},
+		{
+			name:            "Нет активной приемки",
+			handlerErr:      &reception.ErrNoActiveReception{},
+			expectedCode:    codes.FailedPrecondition,
+			expectedReason:  reception.CodeNoActiveReception,
+			expectedMessage: "нет активной приемки",
+		},
+		{
+			name:            "Нет товаров для удаления",
+			handlerErr:      fmt.Errorf("ошибка при удалении товара: %w", &product.ErrNoProductsToDelete{}),
+			expectedCode:    codes.FailedPrecondition,
+			expectedReason:  product.CodeNoProductsToDelete,
+			expectedMessage: "нет товаров для удаления",
+		},
+		{
+			name:            "Ошибка валидации",
+			handlerErr:      &pvz.ValidationError{Key: pvz.DetailInvalidPVZID},
+			expectedCode:    codes.InvalidArgument,
+			expectedReason:  errcode.ValidationFailed,
+			expectedMessage: "неверный формат UUID ПВЗ",
+		},
+		{
+			name:            "Неверный тип товара",
+			handlerErr:      &product.ErrInvalidProductType{},
+			expectedCode:    codes.InvalidArgument,
+			expectedReason:  product.CodeInvalidProductType,
+			expectedMessage: "неверный тип товара",
+		},
+		{
+			name:            "Устаревший курсор событий",
+			handlerErr:      &event.ErrCursorExpired{},
+			expectedCode:    codes.OutOfRange,
+			expectedReason:  event.CodeCursorExpired,
+			expectedMessage: "курсор событий устарел, необходимо заново загрузить состояние",
+		},
+		{
+			name:            "Неизвестная ошибка не раскрывается",
+			handlerErr:      errors.New("pq: connection refused"),
+			expectedCode:    codes.Internal,
+			expectedReason:  errcode.Internal,
+			expectedMessage: "внутренняя ошибка сервера",
+		},
+	}
+
+	interceptor := grpcServer.ErrorUnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: pbpvz.PVZService_GetPVZ_FullMethodName}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(_ context.Context, _ any) (any, error) {
+				return nil, tt.handlerErr
+			}
+
+			_, err := interceptor(context.Background(), nil, info, handler)
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedCode, st.Code())
+			assert.Equal(t, tt.expectedMessage, st.Message())
+
+			require.Len(t, st.Details(), 1)
+			errorInfo, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			assert.Equal(t, string(tt.expectedReason), errorInfo.GetReason())
+		})
+	}
+}
+
+func TestErrorUnaryInterceptor_KeepsStatusErrors(t *testing.T) {
+	interceptor := grpcServer.ErrorUnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: pbpvz.PVZService_GetPVZ_FullMethodName}
+	original := status.Error(codes.Unauthenticated, "невалидный токен")
+
+	_, err := interceptor(context.Background(), nil, info, func(_ context.Context, _ any) (any, error) {
+		return nil, original
+	})
+
+	assert.Equal(t, original, err)
+}
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/event"
+	domainPVZ "avito/internal/domain/pvz"
+	"avito/internal/infrastructure/events"
+	pbpvz "avito/internal/interfaces/grpc/pb"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type EventSubscriber interface {
+	Subscribe(afterSequence uint64) (*events.Subscription, error)
+}
+
+// eventFilter отбирает события по ID и городу ПВЗ.
+// Город ПВЗ не хранится в событии, поэтому кэшируется на время жизни стрима.
+type eventFilter struct {
+	pvzIDs map[uuid.UUID]struct{}
+	city   domainPVZ.City
+	cities map[uuid.UUID]domainPVZ.City
+}
+
+func newEventFilter(req *pbpvz.WatchPVZEventsRequest) (*eventFilter, error) {
+	filter := &eventFilter{
+		pvzIDs: make(map[uuid.UUID]struct{}, len(req.GetPvzIds())),
+		city:   domainPVZ.City(req.GetCity()),
+		cities: make(map[uuid.UUID]domainPVZ.City),
+	}
+
+	if filter.city != "" && !filter.city.Validate() {
+		return nil, &domainPVZ.ErrInvalidCity{}
+	}
+
+	for _, id := range req.GetPvzIds() {
+		pvzID, err := parsePVZID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.pvzIDs[pvzID] = struct{}{}
+	}
+
+	return filter, nil
+}
+
+func (s *pvzServiceServer) WatchPVZEvents(req *pbpvz.WatchPVZEventsRequest, stream grpc.ServerStreamingServer[pbpvz.PVZEvent]) error {
+	filter, err := newEventFilter(req)
+	if err != nil {
+		return err
+	}
+
+	sub, err := s.eventSubscriber.Subscribe(req.GetAfterSequence())
+	if err != nil {
+		s.logger.Error("Ошибка при подписке на события", "error", err)
+		return err
+	}
+	defer sub.Close()
+
+	ctx := stream.Context()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-s.shutdown:
+			return statusError(codes.Unavailable, errcode.ShuttingDown, "сервер останавливается, повторите запрос позже")
+		case e, ok := <-sub.Events():
+			if !ok {
+				return sub.Err()
+			}
+
+			if !s.matchEvent(stream, filter, &e) {
+				continue
+			}
+
+			if err := stream.Send(eventToProto(&e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *pvzServiceServer) matchEvent(stream grpc.ServerStream, filter *eventFilter, e *event.Event) bool {
+	if len(filter.pvzIDs) > 0 {
+		if _, ok := filter.pvzIDs[e.PVZID]; !ok {
+			return false
+		}
+	}
+
+	if filter.city == "" {
+		return true
+	}
+
+	city, ok := filter.cities[e.PVZID]
+	if !ok {
+		pvzObj, err := s.pvzService.GetPVZByID(stream.Context(), e.PVZID.String())
+		if err != nil {
+			s.logger.Error("Ошибка при получении ПВЗ для фильтрации события", "error", err, "pvzID", e.PVZID)
+			return false
+		}
+
+		city = pvzObj.City
+		filter.cities[e.PVZID] = city
+	}
+
+	return city == filter.city
+}
@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	pbpvz "avito/internal/interfaces/grpc/pb"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// DatabaseHealth реализует grpc.health.v1.Health и выставляет статус по доступности базы данных.
+// Статус обновляется для всего сервера (пустое имя сервиса) и для PVZService.
+type DatabaseHealth struct {
+	*health.Server
+	pinger   Pinger
+	interval time.Duration
+	logger   *slog.Logger
+	serving  bool
+}
+
+func NewDatabaseHealth(pinger Pinger, interval time.Duration, logger *slog.Logger) *DatabaseHealth {
+	h := &DatabaseHealth{
+		Server:   health.NewServer(),
+		pinger:   pinger,
+		interval: interval,
+		logger:   logger,
+	}
+
+	h.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return h
+}
+
+// Run проверяет базу данных сразу и далее с заданным интервалом, пока не отменен ctx.
+func (h *DatabaseHealth) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		h.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *DatabaseHealth) check(ctx context.Context) {
+	pingCtx, cancel := context.WithTimeout(ctx, h.interval)
+	defer cancel()
+
+	err := h.pinger.Ping(pingCtx)
+	if err != nil {
+		if h.serving {
+			h.logger.Error("База данных недоступна, gRPC-сервер переведен в NOT_SERVING", "error", err)
+		}
+
+		h.serving = false
+		h.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+		return
+	}
+
+	if !h.serving {
+		h.logger.Info("База данных доступна, gRPC-сервер переведен в SERVING")
+	}
+
+	h.serving = true
+	h.setStatus(healthpb.HealthCheckResponse_SERVING)
+}
+
+// setStatus игнорируется после Shutdown, поэтому периодическая проверка
+// не вернет SERVING во время остановки сервера.
+func (h *DatabaseHealth) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	h.SetServingStatus("", status)
+	h.SetServingStatus(pbpvz.PVZService_ServiceDesc.ServiceName, status)
+}
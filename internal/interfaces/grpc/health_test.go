@@ -0,0 +1,71 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	grpcServer "avito/internal/interfaces/grpc"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type stubPinger struct {
+	failing atomic.Bool
+}
+
+func (p *stubPinger) Ping(_ context.Context) error {
+	if p.failing.Load() {
+		return errors.New("база данных недоступна")
+	}
+
+	return nil
+}
+
+func servingStatus(t *testing.T, h *grpcServer.DatabaseHealth) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	resp, err := h.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+
+	return resp.GetStatus()
+}
+
+func TestDatabaseHealth(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	pinger := &stubPinger{}
+	pinger.failing.Store(true)
+
+	h := grpcServer.NewDatabaseHealth(pinger, 10*time.Millisecond, logger)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, h))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go h.Run(ctx)
+
+	pinger.failing.Store(false)
+	assert.Eventually(t, func() bool {
+		return servingStatus(t, h) == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, 10*time.Millisecond)
+
+	pinger.failing.Store(true)
+	assert.Eventually(t, func() bool {
+		return servingStatus(t, h) == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, 10*time.Millisecond)
+
+	pinger.failing.Store(false)
+	assert.Eventually(t, func() bool {
+		return servingStatus(t, h) == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, 10*time.Millisecond)
+
+	h.Shutdown()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, h))
+}
@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"avito/internal/domain/errcode"
+	"avito/internal/metrics"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func LoggingUnaryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		logger.Info("Получен gRPC-запрос", "method", info.FullMethod)
+
+		resp, err := handler(ctx, req)
+
+		logger.Info("Отправлен gRPC-ответ",
+			"method", info.FullMethod,
+			"code", status.Code(err).String(),
+			"duration", time.Since(start),
+		)
+
+		return resp, err
+	}
+}
+
+func LoggingStreamInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		logger.Info("Открыт gRPC-стрим", "method", info.FullMethod)
+
+		err := handler(srv, ss)
+
+		logger.Info("Закрыт gRPC-стрим",
+			"method", info.FullMethod,
+			"code", status.Code(err).String(),
+			"duration", time.Since(start),
+		)
+
+		return err
+	}
+}
+
+func MetricsUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		metrics.RequestsTotal.Inc()
+
+		resp, err := handler(ctx, req)
+
+		observeGRPC(info.FullMethod, err, time.Since(start))
+
+		return resp, err
+	}
+}
+
+func MetricsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		metrics.RequestsTotal.Inc()
+
+		err := handler(srv, ss)
+
+		observeGRPC(info.FullMethod, err, time.Since(start))
+
+		return err
+	}
+}
+
+func observeGRPC(method string, err error, duration time.Duration) {
+	metrics.GRPCRequestsTotal.WithLabelValues(method, status.Code(err).String()).Inc()
+	metrics.GRPCResponseTime.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+func RecoveryUnaryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+func RecoveryStreamInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+func recoverPanic(logger *slog.Logger, method string, r any) error {
+	logger.Error("Внутренняя ошибка сервера",
+		"error", r,
+		"stack", string(debug.Stack()),
+		"method", method,
+	)
+
+	return statusError(codes.Internal, errcode.Internal, internalErrorMessage)
+}
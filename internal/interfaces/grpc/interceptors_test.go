@@ -0,0 +1,74 @@
+package grpc_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	grpcServer "avito/internal/interfaces/grpc"
+	pbpvz "avito/internal/interfaces/grpc/pb"
+	"avito/internal/metrics"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryUnaryInterceptor(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	interceptor := grpcServer.RecoveryUnaryInterceptor(logger)
+	info := &grpc.UnaryServerInfo{FullMethod: pbpvz.PVZService_CreatePVZ_FullMethodName}
+
+	resp, err := interceptor(context.Background(), nil, info, func(_ context.Context, _ any) (any, error) {
+		panic("неожиданная ошибка")
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestRecoveryStreamInterceptor(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	interceptor := grpcServer.RecoveryStreamInterceptor(logger)
+	info := &grpc.StreamServerInfo{FullMethod: pbpvz.PVZService_WatchPVZEvents_FullMethodName}
+
+	err := interceptor(nil, nil, info, func(_ any, _ grpc.ServerStream) error {
+		panic("неожиданная ошибка")
+	})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestMetricsUnaryInterceptor(t *testing.T) {
+	interceptor := grpcServer.MetricsUnaryInterceptor()
+	method := pbpvz.PVZService_GetPVZ_FullMethodName
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+
+	notFoundBefore := counterValue(t, metrics.GRPCRequestsTotal.WithLabelValues(method, codes.NotFound.String()))
+	totalBefore := counterValue(t, metrics.RequestsTotal)
+
+	_, err := interceptor(context.Background(), nil, info, func(_ context.Context, _ any) (any, error) {
+		return nil, status.Error(codes.NotFound, "ПВЗ не найден")
+	})
+	require.Error(t, err)
+
+	assert.Equal(t, notFoundBefore+1, counterValue(t, metrics.GRPCRequestsTotal.WithLabelValues(method, codes.NotFound.String())))
+	assert.Equal(t, totalBefore+1, counterValue(t, metrics.RequestsTotal))
+}
+
+type metricWriter interface {
+	Write(out *dto.Metric) error
+}
+
+func counterValue(t *testing.T, metric metricWriter) float64 {
+	t.Helper()
+
+	var out dto.Metric
+	require.NoError(t, metric.Write(&out))
+
+	return out.GetCounter().GetValue()
+}
@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+
+	"avito/internal/domain/errcode"
+	"avito/internal/i18n"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// acceptLanguageKey - ключ метаданных с предпочтительными языками клиента в формате Accept-Language.
+const acceptLanguageKey = "accept-language"
+
+// LocalizationUnaryInterceptor выбирает язык по метаданным accept-language, кладет его в контекст
+// и переводит сообщение статуса ошибки по причине из ErrorInfo.
+func LocalizationUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		lang := languageFromMetadata(ctx)
+
+		resp, err := handler(i18n.NewContext(ctx, lang), req)
+		if err != nil {
+			return nil, localizeStatus(lang, err)
+		}
+
+		return resp, nil
+	}
+}
+
+// LocalizationStreamInterceptor делает то же для стримов: язык доступен обработчику через контекст стрима.
+func LocalizationStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		lang := languageFromMetadata(ss.Context())
+		stream := &contextServerStream{ServerStream: ss, ctx: i18n.NewContext(ss.Context(), lang)}
+
+		if err := handler(srv, stream); err != nil {
+			return localizeStatus(lang, err)
+		}
+
+		return nil
+	}
+}
+
+func languageFromMetadata(ctx context.Context) i18n.Language {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return i18n.Default
+	}
+
+	values := md.Get(acceptLanguageKey)
+	if len(values) == 0 {
+		return i18n.Default
+	}
+
+	return i18n.Negotiate(values[0])
+}
+
+// detailFromErrorInfo восстанавливает подробное сообщение из ErrorInfo.Metadata, заполненного detailedStatusError.
+func detailFromErrorInfo(errorInfo *errdetails.ErrorInfo, text string) errcode.Detail {
+	detail := errcode.Detail{Text: text}
+
+	key, ok := errorInfo.GetMetadata()[detailKeyMetadata]
+	if !ok {
+		return detail
+	}
+
+	detail.Key = errcode.DetailKey(key)
+	detail.Params = make(map[string]string, len(errorInfo.GetMetadata())-1)
+
+	for name, value := range errorInfo.GetMetadata() {
+		if name != detailKeyMetadata {
+			detail.Params[name] = value
+		}
+	}
+
+	return detail
+}
+
+// localizeStatus заменяет сообщение статуса переводом. Статусы без нашего ErrorInfo не меняются,
+// детали статуса сохраняются.
+func localizeStatus(lang i18n.Language, err error) error {
+	if lang == i18n.Default {
+		return err
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	for _, detail := range st.Details() {
+		errorInfo, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || errorInfo.GetDomain() != errorDomain {
+			continue
+		}
+
+		proto := st.Proto()
+		proto.Message = i18n.Message(lang, errcode.Code(errorInfo.GetReason()), detailFromErrorInfo(errorInfo, st.Message()))
+
+		return status.FromProto(proto).Err()
+	}
+
+	return err
+}
@@ -0,0 +1,108 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/pvz"
+	"avito/internal/i18n"
+	grpcServer "avito/internal/interfaces/grpc"
+	pbpvz "avito/internal/interfaces/grpc/pb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLocalizationUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name             string
+		acceptLanguage   string
+		handlerErr       error
+		expectedLanguage i18n.Language
+		expectedMessage  string
+		expectedReason   string
+	}{
+		{
+			name:             "Без метаданных",
+			handlerErr:       &pvz.ErrPVZNotFound{},
+			expectedLanguage: i18n.Russian,
+			expectedMessage:  "ПВЗ не найден",
+			expectedReason:   string(pvz.CodePVZNotFound),
+		},
+		{
+			name:             "Английский",
+			acceptLanguage:   "en",
+			handlerErr:       &pvz.ErrPVZNotFound{},
+			expectedLanguage: i18n.English,
+			expectedMessage:  "pickup point not found",
+			expectedReason:   string(pvz.CodePVZNotFound),
+		},
+		{
+			name:             "Подробное сообщение переводится по ключу из ErrorInfo",
+			acceptLanguage:   "en",
+			handlerErr:       &pvz.ValidationError{Key: pvz.DetailUnknownInclude, Params: map[string]string{"value": "events"}},
+			expectedLanguage: i18n.English,
+			expectedMessage:  "unknown include value: events",
+			expectedReason:   string(errcode.ValidationFailed),
+		},
+		{
+			name:             "Неподдерживаемый язык",
+			acceptLanguage:   "fr",
+			handlerErr:       &pvz.ErrPVZNotFound{},
+			expectedLanguage: i18n.Russian,
+			expectedMessage:  "ПВЗ не найден",
+			expectedReason:   string(pvz.CodePVZNotFound),
+		},
+		{
+			name:             "Статус без ErrorInfo не переводится",
+			acceptLanguage:   "en",
+			handlerErr:       status.Error(codes.Unavailable, "сервис недоступен"),
+			expectedLanguage: i18n.English,
+			expectedMessage:  "сервис недоступен",
+		},
+	}
+
+	localization := grpcServer.LocalizationUnaryInterceptor()
+	errorsInterceptor := grpcServer.ErrorUnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: pbpvz.PVZService_GetPVZ_FullMethodName}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.acceptLanguage != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("accept-language", tt.acceptLanguage))
+			}
+
+			var language i18n.Language
+
+			handler := func(ctx context.Context, req any) (any, error) {
+				return errorsInterceptor(ctx, req, info, func(ctx context.Context, _ any) (any, error) {
+					language = i18n.FromContext(ctx)
+
+					return nil, tt.handlerErr
+				})
+			}
+
+			_, err := localization(ctx, nil, info, handler)
+
+			assert.Equal(t, tt.expectedLanguage, language)
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedMessage, st.Message())
+
+			if tt.expectedReason != "" {
+				require.Len(t, st.Details(), 1)
+				errorInfo, ok := st.Details()[0].(*errdetails.ErrorInfo)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedReason, errorInfo.GetReason())
+			}
+		})
+	}
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.35.1
+// 	protoc-gen-go v1.36.6
 // 	protoc        v5.28.2
 // source: api/proto/v1/pvz.proto
 
@@ -12,6 +12,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -67,14 +68,226 @@ func (ReceptionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{0}
 }
 
-type PVZ struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ProductType int32
+
+const (
+	ProductType_PRODUCT_TYPE_UNSPECIFIED ProductType = 0
+	ProductType_PRODUCT_TYPE_ELECTRONICS ProductType = 1
+	ProductType_PRODUCT_TYPE_CLOTHES     ProductType = 2
+	ProductType_PRODUCT_TYPE_SHOES       ProductType = 3
+)
+
+// Enum value maps for ProductType.
+var (
+	ProductType_name = map[int32]string{
+		0: "PRODUCT_TYPE_UNSPECIFIED",
+		1: "PRODUCT_TYPE_ELECTRONICS",
+		2: "PRODUCT_TYPE_CLOTHES",
+		3: "PRODUCT_TYPE_SHOES",
+	}
+	ProductType_value = map[string]int32{
+		"PRODUCT_TYPE_UNSPECIFIED": 0,
+		"PRODUCT_TYPE_ELECTRONICS": 1,
+		"PRODUCT_TYPE_CLOTHES":     2,
+		"PRODUCT_TYPE_SHOES":       3,
+	}
+)
+
+func (x ProductType) Enum() *ProductType {
+	p := new(ProductType)
+	*p = x
+	return p
+}
+
+func (x ProductType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_pvz_proto_enumTypes[1].Descriptor()
+}
+
+func (ProductType) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_pvz_proto_enumTypes[1]
+}
+
+func (x ProductType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductType.Descriptor instead.
+func (ProductType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{1}
+}
+
+type PVZSortField int32
+
+const (
+	PVZSortField_PVZ_SORT_FIELD_REGISTRATION_DATE PVZSortField = 0
+	// Количество всех приемок ПВЗ.
+	PVZSortField_PVZ_SORT_FIELD_RECEPTION_COUNT PVZSortField = 1
+	// Время последнего события: регистрации ПВЗ, создания приемки или добавления товара.
+	PVZSortField_PVZ_SORT_FIELD_LAST_ACTIVITY PVZSortField = 2
+)
+
+// Enum value maps for PVZSortField.
+var (
+	PVZSortField_name = map[int32]string{
+		0: "PVZ_SORT_FIELD_REGISTRATION_DATE",
+		1: "PVZ_SORT_FIELD_RECEPTION_COUNT",
+		2: "PVZ_SORT_FIELD_LAST_ACTIVITY",
+	}
+	PVZSortField_value = map[string]int32{
+		"PVZ_SORT_FIELD_REGISTRATION_DATE": 0,
+		"PVZ_SORT_FIELD_RECEPTION_COUNT":   1,
+		"PVZ_SORT_FIELD_LAST_ACTIVITY":     2,
+	}
+)
+
+func (x PVZSortField) Enum() *PVZSortField {
+	p := new(PVZSortField)
+	*p = x
+	return p
+}
+
+func (x PVZSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PVZSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_pvz_proto_enumTypes[2].Descriptor()
+}
+
+func (PVZSortField) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_pvz_proto_enumTypes[2]
+}
+
+func (x PVZSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PVZSortField.Descriptor instead.
+func (PVZSortField) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{2}
+}
+
+type ScanAction int32
+
+const (
+	ScanAction_SCAN_ACTION_UNSPECIFIED ScanAction = 0
+	ScanAction_SCAN_ACTION_STARTED     ScanAction = 1
+	ScanAction_SCAN_ACTION_ADDED       ScanAction = 2
+	ScanAction_SCAN_ACTION_UNDONE      ScanAction = 3
+)
+
+// Enum value maps for ScanAction.
+var (
+	ScanAction_name = map[int32]string{
+		0: "SCAN_ACTION_UNSPECIFIED",
+		1: "SCAN_ACTION_STARTED",
+		2: "SCAN_ACTION_ADDED",
+		3: "SCAN_ACTION_UNDONE",
+	}
+	ScanAction_value = map[string]int32{
+		"SCAN_ACTION_UNSPECIFIED": 0,
+		"SCAN_ACTION_STARTED":     1,
+		"SCAN_ACTION_ADDED":       2,
+		"SCAN_ACTION_UNDONE":      3,
+	}
+)
+
+func (x ScanAction) Enum() *ScanAction {
+	p := new(ScanAction)
+	*p = x
+	return p
+}
+
+func (x ScanAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScanAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_pvz_proto_enumTypes[3].Descriptor()
+}
+
+func (ScanAction) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_pvz_proto_enumTypes[3]
+}
+
+func (x ScanAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScanAction.Descriptor instead.
+func (ScanAction) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+type PVZEventType int32
+
+const (
+	PVZEventType_PVZ_EVENT_TYPE_UNSPECIFIED       PVZEventType = 0
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CREATED PVZEventType = 1
+	PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED     PVZEventType = 2
+	PVZEventType_PVZ_EVENT_TYPE_PRODUCT_DELETED   PVZEventType = 3
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CLOSED  PVZEventType = 4
+)
+
+// Enum value maps for PVZEventType.
+var (
+	PVZEventType_name = map[int32]string{
+		0: "PVZ_EVENT_TYPE_UNSPECIFIED",
+		1: "PVZ_EVENT_TYPE_RECEPTION_CREATED",
+		2: "PVZ_EVENT_TYPE_PRODUCT_ADDED",
+		3: "PVZ_EVENT_TYPE_PRODUCT_DELETED",
+		4: "PVZ_EVENT_TYPE_RECEPTION_CLOSED",
+	}
+	PVZEventType_value = map[string]int32{
+		"PVZ_EVENT_TYPE_UNSPECIFIED":       0,
+		"PVZ_EVENT_TYPE_RECEPTION_CREATED": 1,
+		"PVZ_EVENT_TYPE_PRODUCT_ADDED":     2,
+		"PVZ_EVENT_TYPE_PRODUCT_DELETED":   3,
+		"PVZ_EVENT_TYPE_RECEPTION_CLOSED":  4,
+	}
+)
+
+func (x PVZEventType) Enum() *PVZEventType {
+	p := new(PVZEventType)
+	*p = x
+	return p
+}
+
+func (x PVZEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PVZEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_pvz_proto_enumTypes[4].Descriptor()
+}
+
+func (PVZEventType) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_pvz_proto_enumTypes[4]
+}
+
+func (x PVZEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PVZEventType.Descriptor instead.
+func (PVZEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{4}
+}
 
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	RegistrationDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
-	City             string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+type PVZ struct {
+	state            protoimpl.MessageState   `protogen:"open.v1"`
+	Id               string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RegistrationDate *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
+	City             string                   `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Receptions       []*ReceptionWithProducts `protobuf:"bytes,4,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	// Приемки обрезаны по receptions_limit, остальные доступны через ListReceptions.
+	HasMoreReceptions bool `protobuf:"varint,5,opt,name=has_more_receptions,json=hasMoreReceptions,proto3" json:"has_more_receptions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *PVZ) Reset() {
@@ -128,26 +341,44 @@ func (x *PVZ) GetCity() string {
 	return ""
 }
 
-type GetPVZListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *PVZ) GetReceptions() []*ReceptionWithProducts {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+func (x *PVZ) GetHasMoreReceptions() bool {
+	if x != nil {
+		return x.HasMoreReceptions
+	}
+	return false
+}
+
+type Reception struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	PvzId         string                 `protobuf:"bytes,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status        ReceptionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=pvz.v1.ReceptionStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPVZListRequest) Reset() {
-	*x = GetPVZListRequest{}
+func (x *Reception) Reset() {
+	*x = Reception{}
 	mi := &file_api_proto_v1_pvz_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPVZListRequest) String() string {
+func (x *Reception) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPVZListRequest) ProtoMessage() {}
+func (*Reception) ProtoMessage() {}
 
-func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
+func (x *Reception) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_v1_pvz_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -159,33 +390,63 @@ func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPVZListRequest.ProtoReflect.Descriptor instead.
-func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Reception.ProtoReflect.Descriptor instead.
+func (*Reception) Descriptor() ([]byte, []int) {
 	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{1}
 }
 
-type GetPVZListResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Reception) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
 
-	Pvzs []*PVZ `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+func (x *Reception) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
 }
 
-func (x *GetPVZListResponse) Reset() {
-	*x = GetPVZListResponse{}
+func (x *Reception) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *Reception) GetStatus() ReceptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	Type          ProductType            `protobuf:"varint,3,opt,name=type,proto3,enum=pvz.v1.ProductType" json:"type,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,4,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
 	mi := &file_api_proto_v1_pvz_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPVZListResponse) String() string {
+func (x *Product) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPVZListResponse) ProtoMessage() {}
+func (*Product) ProtoMessage() {}
 
-func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
+func (x *Product) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_v1_pvz_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -197,97 +458,2041 @@ func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPVZListResponse.ProtoReflect.Descriptor instead.
-func (*GetPVZListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
 	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetPVZListResponse) GetPvzs() []*PVZ {
+func (x *Product) GetId() string {
 	if x != nil {
-		return x.Pvzs
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
 	}
 	return nil
 }
 
-var File_api_proto_v1_pvz_proto protoreflect.FileDescriptor
+func (x *Product) GetType() ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return ProductType_PRODUCT_TYPE_UNSPECIFIED
+}
 
-var file_api_proto_v1_pvz_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x76, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x72, 0x0a, 0x03, 0x50, 0x56, 0x5a, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x11, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x69, 0x74, 0x79, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x56, 0x5a, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x50, 0x56, 0x5a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1f, 0x0a, 0x04, 0x70, 0x76, 0x7a, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x56, 0x5a, 0x52, 0x04, 0x70, 0x76, 0x7a,
-	0x73, 0x2a, 0x50, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x43, 0x45, 0x50, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47,
-	0x52, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x43, 0x45, 0x50, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
-	0x44, 0x10, 0x01, 0x32, 0x51, 0x0a, 0x0a, 0x50, 0x56, 0x5a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x56, 0x5a, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x56, 0x5a, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x76, 0x7a,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x56, 0x5a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x20, 0x5a, 0x1e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x67, 0x72,
-	0x70, 0x63, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Product) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
 }
 
-var (
-	file_api_proto_v1_pvz_proto_rawDescOnce sync.Once
-	file_api_proto_v1_pvz_proto_rawDescData = file_api_proto_v1_pvz_proto_rawDesc
-)
+type ReceptionWithProducts struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Reception *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	Products  []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	// Товары обрезаны по products_limit, остальные доступны через ListProducts.
+	HasMoreProducts bool `protobuf:"varint,3,opt,name=has_more_products,json=hasMoreProducts,proto3" json:"has_more_products,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
 
-func file_api_proto_v1_pvz_proto_rawDescGZIP() []byte {
-	file_api_proto_v1_pvz_proto_rawDescOnce.Do(func() {
-		file_api_proto_v1_pvz_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_proto_v1_pvz_proto_rawDescData)
-	})
-	return file_api_proto_v1_pvz_proto_rawDescData
+func (x *ReceptionWithProducts) Reset() {
+	*x = ReceptionWithProducts{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var file_api_proto_v1_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_proto_v1_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_api_proto_v1_pvz_proto_goTypes = []any{
-	(ReceptionStatus)(0),          // 0: pvz.v1.ReceptionStatus
-	(*PVZ)(nil),                   // 1: pvz.v1.PVZ
-	(*GetPVZListRequest)(nil),     // 2: pvz.v1.GetPVZListRequest
-	(*GetPVZListResponse)(nil),    // 3: pvz.v1.GetPVZListResponse
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+func (x *ReceptionWithProducts) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_api_proto_v1_pvz_proto_depIdxs = []int32{
-	4, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
-	1, // 1: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
-	2, // 2: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
-	3, // 3: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+func (*ReceptionWithProducts) ProtoMessage() {}
+
+func (x *ReceptionWithProducts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_api_proto_v1_pvz_proto_init() }
-func file_api_proto_v1_pvz_proto_init() {
-	if File_api_proto_v1_pvz_proto != nil {
-		return
+// Deprecated: Use ReceptionWithProducts.ProtoReflect.Descriptor instead.
+func (*ReceptionWithProducts) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReceptionWithProducts) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *ReceptionWithProducts) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ReceptionWithProducts) GetHasMoreProducts() bool {
+	if x != nil {
+		return x.HasMoreProducts
+	}
+	return false
+}
+
+// Фильтры объединяются по И, значения повторяющихся полей - по ИЛИ. Фильтры приемок (start_date, end_date,
+// reception_statuses, product_types) выбирают приемки: в список попадают ПВЗ хотя бы с одной выбранной приемкой,
+// и вкладываются только выбранные приемки.
+type GetPVZListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Фильтр по городу, пустая строка - без фильтра. Объединяется с cities.
+	City string `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	// Диапазон дат приемок.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Пагинация: page >= 1, limit от 1 до 30. Нулевые значения заменяются на page=1, limit=10.
+	Page  int32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Курсор из next_page_token предыдущего ответа. Несовместим с page > 1, фильтры и limit должны совпадать
+	// с предыдущим запросом.
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Посчитать общее количество ПВЗ под фильтрами.
+	WithTotal bool `protobuf:"varint,7,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`
+	// Вложенные данные: "receptions", "products" или "none" - только ПВЗ. Пустой список - вкладываются
+	// приемки и товары, товары без приемок не вкладываются.
+	Include []string `protobuf:"bytes,8,rep,name=include,proto3" json:"include,omitempty"`
+	// Максимум последних приемок на ПВЗ (до 100) и первых товаров на приемку (до 500), 0 - без ограничения.
+	ReceptionsLimit   int32             `protobuf:"varint,9,opt,name=receptions_limit,json=receptionsLimit,proto3" json:"receptions_limit,omitempty"`
+	ProductsLimit     int32             `protobuf:"varint,10,opt,name=products_limit,json=productsLimit,proto3" json:"products_limit,omitempty"`
+	Cities            []string          `protobuf:"bytes,11,rep,name=cities,proto3" json:"cities,omitempty"`
+	ReceptionStatuses []ReceptionStatus `protobuf:"varint,12,rep,packed,name=reception_statuses,json=receptionStatuses,proto3,enum=pvz.v1.ReceptionStatus" json:"reception_statuses,omitempty"`
+	// Приемки хотя бы с одним товаром этих типов.
+	ProductTypes []ProductType `protobuf:"varint,13,rep,packed,name=product_types,json=productTypes,proto3,enum=pvz.v1.ProductType" json:"product_types,omitempty"`
+	// Только ПВЗ с незакрытой приемкой (true) или без нее (false).
+	HasOpenReception *bool `protobuf:"varint,14,opt,name=has_open_reception,json=hasOpenReception,proto3,oneof" json:"has_open_reception,omitempty"`
+	// Диапазон дат регистрации ПВЗ.
+	RegisteredFrom *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=registered_from,json=registeredFrom,proto3" json:"registered_from,omitempty"`
+	RegisteredTo   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=registered_to,json=registeredTo,proto3" json:"registered_to,omitempty"`
+	// Сортировка, по умолчанию - от новых к старым по дате регистрации. Курсор действителен только для той
+	// сортировки, с которой получен.
+	SortField     PVZSortField `protobuf:"varint,17,opt,name=sort_field,json=sortField,proto3,enum=pvz.v1.PVZSortField" json:"sort_field,omitempty"`
+	Ascending     bool         `protobuf:"varint,18,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZListRequest) Reset() {
+	*x = GetPVZListRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListRequest) ProtoMessage() {}
+
+func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPVZListRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *GetPVZListRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPVZListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPVZListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetPVZListRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+func (x *GetPVZListRequest) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetReceptionsLimit() int32 {
+	if x != nil {
+		return x.ReceptionsLimit
+	}
+	return 0
+}
+
+func (x *GetPVZListRequest) GetProductsLimit() int32 {
+	if x != nil {
+		return x.ProductsLimit
+	}
+	return 0
+}
+
+func (x *GetPVZListRequest) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetReceptionStatuses() []ReceptionStatus {
+	if x != nil {
+		return x.ReceptionStatuses
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetProductTypes() []ProductType {
+	if x != nil {
+		return x.ProductTypes
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetHasOpenReception() bool {
+	if x != nil && x.HasOpenReception != nil {
+		return *x.HasOpenReception
+	}
+	return false
+}
+
+func (x *GetPVZListRequest) GetRegisteredFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegisteredFrom
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetRegisteredTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegisteredTo
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetSortField() PVZSortField {
+	if x != nil {
+		return x.SortField
+	}
+	return PVZSortField_PVZ_SORT_FIELD_REGISTRATION_DATE
+}
+
+func (x *GetPVZListRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+type GetPVZListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs  []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	// Курсор следующей страницы, пустой на последней странице.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Общее количество ПВЗ под фильтрами, заполняется только при with_total.
+	TotalCount    *int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZListResponse) Reset() {
+	*x = GetPVZListResponse{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListResponse) ProtoMessage() {}
+
+func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZListResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPVZListResponse) GetPvzs() []*PVZ {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+func (x *GetPVZListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetPVZListResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+type GetPVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZRequest) Reset() {
+	*x = GetPVZRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZRequest) ProtoMessage() {}
+
+func (x *GetPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPVZRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZResponse) Reset() {
+	*x = GetPVZResponse{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZResponse) ProtoMessage() {}
+
+func (x *GetPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type BatchGetPVZRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Не более 30 ID, повторяющиеся ID учитываются один раз.
+	Ids           []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPVZRequest) Reset() {
+	*x = BatchGetPVZRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPVZRequest) ProtoMessage() {}
+
+func (x *BatchGetPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPVZRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetPVZRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchGetPVZRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetPVZResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Найденные ПВЗ в порядке ID из запроса.
+	Pvzs          []*PVZ   `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	NotFoundIds   []string `protobuf:"bytes,2,rep,name=not_found_ids,json=notFoundIds,proto3" json:"not_found_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPVZResponse) Reset() {
+	*x = BatchGetPVZResponse{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPVZResponse) ProtoMessage() {}
+
+func (x *BatchGetPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPVZResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetPVZResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchGetPVZResponse) GetPvzs() []*PVZ {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+func (x *BatchGetPVZResponse) GetNotFoundIds() []string {
+	if x != nil {
+		return x.NotFoundIds
+	}
+	return nil
+}
+
+type CreatePVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePVZRequest) Reset() {
+	*x = CreatePVZRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZRequest) ProtoMessage() {}
+
+func (x *CreatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZRequest.ProtoReflect.Descriptor instead.
+func (*CreatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreatePVZRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type CreatePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePVZResponse) Reset() {
+	*x = CreatePVZResponse{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZResponse) ProtoMessage() {}
+
+func (x *CreatePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZResponse.ProtoReflect.Descriptor instead.
+func (*CreatePVZResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreatePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type ListReceptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	PvzId string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	// От 1 до 100, 0 заменяется на 10.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Курсор из next_page_token предыдущего ответа.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceptionsRequest) Reset() {
+	*x = ListReceptionsRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceptionsRequest) ProtoMessage() {}
+
+func (x *ListReceptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListReceptionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListReceptionsRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *ListReceptionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReceptionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Приемки ПВЗ от новых к старым.
+type ListReceptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receptions    []*Reception           `protobuf:"bytes,1,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceptionsResponse) Reset() {
+	*x = ListReceptionsResponse{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceptionsResponse) ProtoMessage() {}
+
+func (x *ListReceptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReceptionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListReceptionsResponse) GetReceptions() []*Reception {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+func (x *ListReceptionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListProductsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	// От 1 до 500, 0 заменяется на 50.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Курсор из next_page_token предыдущего ответа.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListProductsRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Товары приемки в порядке добавления.
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceptionRequest) Reset() {
+	*x = CreateReceptionRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceptionRequest) ProtoMessage() {}
+
+func (x *CreateReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type CreateReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceptionResponse) Reset() {
+	*x = CreateReceptionResponse{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceptionResponse) ProtoMessage() {}
+
+func (x *CreateReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type CloseLastReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseLastReceptionRequest) Reset() {
+	*x = CloseLastReceptionRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLastReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLastReceptionRequest) ProtoMessage() {}
+
+func (x *CloseLastReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLastReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CloseLastReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CloseLastReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type CloseLastReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseLastReceptionResponse) Reset() {
+	*x = CloseLastReceptionResponse{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLastReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLastReceptionResponse) ProtoMessage() {}
+
+func (x *CloseLastReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLastReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CloseLastReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CloseLastReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type AddProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Type          ProductType            `protobuf:"varint,2,opt,name=type,proto3,enum=pvz.v1.ProductType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductRequest) Reset() {
+	*x = AddProductRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductRequest) ProtoMessage() {}
+
+func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductRequest.ProtoReflect.Descriptor instead.
+func (*AddProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetType() ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return ProductType_PRODUCT_TYPE_UNSPECIFIED
+}
+
+type AddProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductResponse) Reset() {
+	*x = AddProductResponse{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductResponse) ProtoMessage() {}
+
+func (x *AddProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductResponse.ProtoReflect.Descriptor instead.
+func (*AddProductResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type DeleteLastProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductRequest) Reset() {
+	*x = DeleteLastProductRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductRequest) ProtoMessage() {}
+
+func (x *DeleteLastProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteLastProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type DeleteLastProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductResponse) Reset() {
+	*x = DeleteLastProductResponse{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductResponse) ProtoMessage() {}
+
+func (x *DeleteLastProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{23}
+}
+
+// Первым сообщением стрима должен быть start: ПВЗ и активная приемка проверяются один раз.
+// Далее клиент присылает сканы и отмены, на каждое сообщение сервер отвечает одним ack в том же порядке.
+type ScanProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Command:
+	//
+	//	*ScanProductsRequest_Start
+	//	*ScanProductsRequest_Scan
+	//	*ScanProductsRequest_Undo
+	Command       isScanProductsRequest_Command `protobuf_oneof:"command"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanProductsRequest) Reset() {
+	*x = ScanProductsRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanProductsRequest) ProtoMessage() {}
+
+func (x *ScanProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanProductsRequest.ProtoReflect.Descriptor instead.
+func (*ScanProductsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ScanProductsRequest) GetCommand() isScanProductsRequest_Command {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *ScanProductsRequest) GetStart() *StartScan {
+	if x != nil {
+		if x, ok := x.Command.(*ScanProductsRequest_Start); ok {
+			return x.Start
+		}
+	}
+	return nil
+}
+
+func (x *ScanProductsRequest) GetScan() *ProductScan {
+	if x != nil {
+		if x, ok := x.Command.(*ScanProductsRequest_Scan); ok {
+			return x.Scan
+		}
+	}
+	return nil
+}
+
+func (x *ScanProductsRequest) GetUndo() *UndoLastScan {
+	if x != nil {
+		if x, ok := x.Command.(*ScanProductsRequest_Undo); ok {
+			return x.Undo
+		}
+	}
+	return nil
+}
+
+type isScanProductsRequest_Command interface {
+	isScanProductsRequest_Command()
+}
+
+type ScanProductsRequest_Start struct {
+	Start *StartScan `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type ScanProductsRequest_Scan struct {
+	Scan *ProductScan `protobuf:"bytes,2,opt,name=scan,proto3,oneof"`
+}
+
+type ScanProductsRequest_Undo struct {
+	Undo *UndoLastScan `protobuf:"bytes,3,opt,name=undo,proto3,oneof"`
+}
+
+func (*ScanProductsRequest_Start) isScanProductsRequest_Command() {}
+
+func (*ScanProductsRequest_Scan) isScanProductsRequest_Command() {}
+
+func (*ScanProductsRequest_Undo) isScanProductsRequest_Command() {}
+
+type StartScan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartScan) Reset() {
+	*x = StartScan{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartScan) ProtoMessage() {}
+
+func (x *StartScan) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartScan.ProtoReflect.Descriptor instead.
+func (*StartScan) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StartScan) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type ProductScan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ProductType            `protobuf:"varint,1,opt,name=type,proto3,enum=pvz.v1.ProductType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductScan) Reset() {
+	*x = ProductScan{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductScan) ProtoMessage() {}
+
+func (x *ProductScan) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductScan.ProtoReflect.Descriptor instead.
+func (*ProductScan) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProductScan) GetType() ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return ProductType_PRODUCT_TYPE_UNSPECIFIED
+}
+
+// Удаляет последний товар приемки, как DeleteLastProduct.
+type UndoLastScan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoLastScan) Reset() {
+	*x = UndoLastScan{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoLastScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoLastScan) ProtoMessage() {}
+
+func (x *UndoLastScan) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoLastScan.ProtoReflect.Descriptor instead.
+func (*UndoLastScan) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{27}
+}
+
+type ScanError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Машиночитаемая причина, совпадает с ErrorInfo.reason в статусах gRPC.
+	Reason        string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanError) Reset() {
+	*x = ScanError{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanError) ProtoMessage() {}
+
+func (x *ScanError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanError.ProtoReflect.Descriptor instead.
+func (*ScanError) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ScanError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScanError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ScanProductsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Action ScanAction             `protobuf:"varint,1,opt,name=action,proto3,enum=pvz.v1.ScanAction" json:"action,omitempty"`
+	// Порядковый номер добавленного или удаленного товара в приемке.
+	SequenceNumber int64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// Заполняется для STARTED.
+	Reception *Reception `protobuf:"bytes,3,opt,name=reception,proto3" json:"reception,omitempty"`
+	// Заполняется для ADDED и UNDONE.
+	Product *Product `protobuf:"bytes,4,opt,name=product,proto3" json:"product,omitempty"`
+	// Ошибка, после которой стрим продолжает работу: неверный тип товара или нечего отменять.
+	// Остальные ошибки, например закрытие приемки, завершают стрим статусом gRPC.
+	Error         *ScanError `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanProductsResponse) Reset() {
+	*x = ScanProductsResponse{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanProductsResponse) ProtoMessage() {}
+
+func (x *ScanProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanProductsResponse.ProtoReflect.Descriptor instead.
+func (*ScanProductsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ScanProductsResponse) GetAction() ScanAction {
+	if x != nil {
+		return x.Action
+	}
+	return ScanAction_SCAN_ACTION_UNSPECIFIED
+}
+
+func (x *ScanProductsResponse) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *ScanProductsResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *ScanProductsResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ScanProductsResponse) GetError() *ScanError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type WatchPVZEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Фильтр по ID ПВЗ, пустой список - события всех ПВЗ.
+	PvzIds []string `protobuf:"bytes,1,rep,name=pvz_ids,json=pvzIds,proto3" json:"pvz_ids,omitempty"`
+	// Фильтр по городу ПВЗ, пустая строка - без фильтра.
+	City string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	// Номер последнего полученного события. Сервер пришлет события с большим номером.
+	// Нулевое значение - только новые события.
+	AfterSequence uint64 `protobuf:"varint,3,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPVZEventsRequest) Reset() {
+	*x = WatchPVZEventsRequest{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPVZEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPVZEventsRequest) ProtoMessage() {}
+
+func (x *WatchPVZEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPVZEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPVZEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WatchPVZEventsRequest) GetPvzIds() []string {
+	if x != nil {
+		return x.PvzIds
+	}
+	return nil
+}
+
+func (x *WatchPVZEventsRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *WatchPVZEventsRequest) GetAfterSequence() uint64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+type PVZEvent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Sequence    uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type        PVZEventType           `protobuf:"varint,2,opt,name=type,proto3,enum=pvz.v1.PVZEventType" json:"type,omitempty"`
+	OccurredAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	PvzId       string                 `protobuf:"bytes,4,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	ReceptionId string                 `protobuf:"bytes,5,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	// Заполняется для PVZ_EVENT_TYPE_PRODUCT_ADDED и PVZ_EVENT_TYPE_PRODUCT_DELETED.
+	Product       *Product `protobuf:"bytes,6,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZEvent) Reset() {
+	*x = PVZEvent{}
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZEvent) ProtoMessage() {}
+
+func (x *PVZEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_pvz_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZEvent.ProtoReflect.Descriptor instead.
+func (*PVZEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_pvz_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PVZEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PVZEvent) GetType() PVZEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PVZEventType_PVZ_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PVZEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *PVZEvent) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+var File_api_proto_v1_pvz_proto protoreflect.FileDescriptor
+
+const file_api_proto_v1_pvz_proto_rawDesc = "" +
+	"\n" +
+	"\x16api/proto/v1/pvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe1\x01\n" +
+	"\x03PVZ\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
+	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12=\n" +
+	"\n" +
+	"receptions\x18\x04 \x03(\v2\x1d.pvz.v1.ReceptionWithProductsR\n" +
+	"receptions\x12.\n" +
+	"\x13has_more_receptions\x18\x05 \x01(\bR\x11hasMoreReceptions\"\x9c\x01\n" +
+	"\tReception\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x15\n" +
+	"\x06pvz_id\x18\x03 \x01(\tR\x05pvzId\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.pvz.v1.ReceptionStatusR\x06status\"\x9e\x01\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12'\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x13.pvz.v1.ProductTypeR\x04type\x12!\n" +
+	"\freception_id\x18\x04 \x01(\tR\vreceptionId\"\xa1\x01\n" +
+	"\x15ReceptionWithProducts\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12+\n" +
+	"\bproducts\x18\x02 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\x12*\n" +
+	"\x11has_more_products\x18\x03 \x01(\bR\x0fhasMoreProducts\"\xaa\x06\n" +
+	"\x11GetPVZListRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\a \x01(\bR\twithTotal\x12\x18\n" +
+	"\ainclude\x18\b \x03(\tR\ainclude\x12)\n" +
+	"\x10receptions_limit\x18\t \x01(\x05R\x0freceptionsLimit\x12%\n" +
+	"\x0eproducts_limit\x18\n" +
+	" \x01(\x05R\rproductsLimit\x12\x16\n" +
+	"\x06cities\x18\v \x03(\tR\x06cities\x12F\n" +
+	"\x12reception_statuses\x18\f \x03(\x0e2\x17.pvz.v1.ReceptionStatusR\x11receptionStatuses\x128\n" +
+	"\rproduct_types\x18\r \x03(\x0e2\x13.pvz.v1.ProductTypeR\fproductTypes\x121\n" +
+	"\x12has_open_reception\x18\x0e \x01(\bH\x00R\x10hasOpenReception\x88\x01\x01\x12C\n" +
+	"\x0fregistered_from\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x0eregisteredFrom\x12?\n" +
+	"\rregistered_to\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\fregisteredTo\x123\n" +
+	"\n" +
+	"sort_field\x18\x11 \x01(\x0e2\x14.pvz.v1.PVZSortFieldR\tsortField\x12\x1c\n" +
+	"\tascending\x18\x12 \x01(\bR\tascendingB\x15\n" +
+	"\x13_has_open_reception\"\x93\x01\n" +
+	"\x12GetPVZListResponse\x12\x1f\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"\x1f\n" +
+	"\rGetPVZRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x0eGetPVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"&\n" +
+	"\x12BatchGetPVZRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"Z\n" +
+	"\x13BatchGetPVZResponse\x12\x1f\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs\x12\"\n" +
+	"\rnot_found_ids\x18\x02 \x03(\tR\vnotFoundIds\"&\n" +
+	"\x10CreatePVZRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\"2\n" +
+	"\x11CreatePVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"c\n" +
+	"\x15ListReceptionsRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"s\n" +
+	"\x16ListReceptionsResponse\x121\n" +
+	"\n" +
+	"receptions\x18\x01 \x03(\v2\x11.pvz.v1.ReceptionR\n" +
+	"receptions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"m\n" +
+	"\x13ListProductsRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"k\n" +
+	"\x14ListProductsResponse\x12+\n" +
+	"\bproducts\x18\x01 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"/\n" +
+	"\x16CreateReceptionRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"J\n" +
+	"\x17CreateReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"2\n" +
+	"\x19CloseLastReceptionRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"M\n" +
+	"\x1aCloseLastReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"S\n" +
+	"\x11AddProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12'\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x13.pvz.v1.ProductTypeR\x04type\"?\n" +
+	"\x12AddProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"1\n" +
+	"\x18DeleteLastProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"\x1b\n" +
+	"\x19DeleteLastProductResponse\"\xa2\x01\n" +
+	"\x13ScanProductsRequest\x12)\n" +
+	"\x05start\x18\x01 \x01(\v2\x11.pvz.v1.StartScanH\x00R\x05start\x12)\n" +
+	"\x04scan\x18\x02 \x01(\v2\x13.pvz.v1.ProductScanH\x00R\x04scan\x12*\n" +
+	"\x04undo\x18\x03 \x01(\v2\x14.pvz.v1.UndoLastScanH\x00R\x04undoB\t\n" +
+	"\acommand\"\"\n" +
+	"\tStartScan\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"6\n" +
+	"\vProductScan\x12'\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x13.pvz.v1.ProductTypeR\x04type\"\x0e\n" +
+	"\fUndoLastScan\"=\n" +
+	"\tScanError\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xf0\x01\n" +
+	"\x14ScanProductsResponse\x12*\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x12.pvz.v1.ScanActionR\x06action\x12'\n" +
+	"\x0fsequence_number\x18\x02 \x01(\x03R\x0esequenceNumber\x12/\n" +
+	"\treception\x18\x03 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12)\n" +
+	"\aproduct\x18\x04 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\x12'\n" +
+	"\x05error\x18\x05 \x01(\v2\x11.pvz.v1.ScanErrorR\x05error\"k\n" +
+	"\x15WatchPVZEventsRequest\x12\x17\n" +
+	"\apvz_ids\x18\x01 \x03(\tR\x06pvzIds\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12%\n" +
+	"\x0eafter_sequence\x18\x03 \x01(\x04R\rafterSequence\"\xf2\x01\n" +
+	"\bPVZEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12(\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x14.pvz.v1.PVZEventTypeR\x04type\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x15\n" +
+	"\x06pvz_id\x18\x04 \x01(\tR\x05pvzId\x12!\n" +
+	"\freception_id\x18\x05 \x01(\tR\vreceptionId\x12)\n" +
+	"\aproduct\x18\x06 \x01(\v2\x0f.pvz.v1.ProductR\aproduct*P\n" +
+	"\x0fReceptionStatus\x12 \n" +
+	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
+	"\x17RECEPTION_STATUS_CLOSED\x10\x01*{\n" +
+	"\vProductType\x12\x1c\n" +
+	"\x18PRODUCT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PRODUCT_TYPE_ELECTRONICS\x10\x01\x12\x18\n" +
+	"\x14PRODUCT_TYPE_CLOTHES\x10\x02\x12\x16\n" +
+	"\x12PRODUCT_TYPE_SHOES\x10\x03*z\n" +
+	"\fPVZSortField\x12$\n" +
+	" PVZ_SORT_FIELD_REGISTRATION_DATE\x10\x00\x12\"\n" +
+	"\x1ePVZ_SORT_FIELD_RECEPTION_COUNT\x10\x01\x12 \n" +
+	"\x1cPVZ_SORT_FIELD_LAST_ACTIVITY\x10\x02*q\n" +
+	"\n" +
+	"ScanAction\x12\x1b\n" +
+	"\x17SCAN_ACTION_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SCAN_ACTION_STARTED\x10\x01\x12\x15\n" +
+	"\x11SCAN_ACTION_ADDED\x10\x02\x12\x16\n" +
+	"\x12SCAN_ACTION_UNDONE\x10\x03*\xbf\x01\n" +
+	"\fPVZEventType\x12\x1e\n" +
+	"\x1aPVZ_EVENT_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" PVZ_EVENT_TYPE_RECEPTION_CREATED\x10\x01\x12 \n" +
+	"\x1cPVZ_EVENT_TYPE_PRODUCT_ADDED\x10\x02\x12\"\n" +
+	"\x1ePVZ_EVENT_TYPE_PRODUCT_DELETED\x10\x03\x12#\n" +
+	"\x1fPVZ_EVENT_TYPE_RECEPTION_CLOSED\x10\x042\x94\a\n" +
+	"\n" +
+	"PVZService\x12C\n" +
+	"\n" +
+	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponse\x127\n" +
+	"\x06GetPVZ\x12\x15.pvz.v1.GetPVZRequest\x1a\x16.pvz.v1.GetPVZResponse\x12F\n" +
+	"\vBatchGetPVZ\x12\x1a.pvz.v1.BatchGetPVZRequest\x1a\x1b.pvz.v1.BatchGetPVZResponse\x12@\n" +
+	"\tCreatePVZ\x12\x18.pvz.v1.CreatePVZRequest\x1a\x19.pvz.v1.CreatePVZResponse\x12R\n" +
+	"\x0fCreateReception\x12\x1e.pvz.v1.CreateReceptionRequest\x1a\x1f.pvz.v1.CreateReceptionResponse\x12[\n" +
+	"\x12CloseLastReception\x12!.pvz.v1.CloseLastReceptionRequest\x1a\".pvz.v1.CloseLastReceptionResponse\x12O\n" +
+	"\x0eListReceptions\x12\x1d.pvz.v1.ListReceptionsRequest\x1a\x1e.pvz.v1.ListReceptionsResponse\x12C\n" +
+	"\n" +
+	"AddProduct\x12\x19.pvz.v1.AddProductRequest\x1a\x1a.pvz.v1.AddProductResponse\x12X\n" +
+	"\x11DeleteLastProduct\x12 .pvz.v1.DeleteLastProductRequest\x1a!.pvz.v1.DeleteLastProductResponse\x12I\n" +
+	"\fListProducts\x12\x1b.pvz.v1.ListProductsRequest\x1a\x1c.pvz.v1.ListProductsResponse\x12M\n" +
+	"\fScanProducts\x12\x1b.pvz.v1.ScanProductsRequest\x1a\x1c.pvz.v1.ScanProductsResponse(\x010\x01\x12C\n" +
+	"\x0eWatchPVZEvents\x12\x1d.pvz.v1.WatchPVZEventsRequest\x1a\x10.pvz.v1.PVZEvent0\x01B Z\x1einternal/interfaces/grpc/pb;pbb\x06proto3"
+
+var (
+	file_api_proto_v1_pvz_proto_rawDescOnce sync.Once
+	file_api_proto_v1_pvz_proto_rawDescData []byte
+)
+
+func file_api_proto_v1_pvz_proto_rawDescGZIP() []byte {
+	file_api_proto_v1_pvz_proto_rawDescOnce.Do(func() {
+		file_api_proto_v1_pvz_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_v1_pvz_proto_rawDesc), len(file_api_proto_v1_pvz_proto_rawDesc)))
+	})
+	return file_api_proto_v1_pvz_proto_rawDescData
+}
+
+var file_api_proto_v1_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_api_proto_v1_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_api_proto_v1_pvz_proto_goTypes = []any{
+	(ReceptionStatus)(0),               // 0: pvz.v1.ReceptionStatus
+	(ProductType)(0),                   // 1: pvz.v1.ProductType
+	(PVZSortField)(0),                  // 2: pvz.v1.PVZSortField
+	(ScanAction)(0),                    // 3: pvz.v1.ScanAction
+	(PVZEventType)(0),                  // 4: pvz.v1.PVZEventType
+	(*PVZ)(nil),                        // 5: pvz.v1.PVZ
+	(*Reception)(nil),                  // 6: pvz.v1.Reception
+	(*Product)(nil),                    // 7: pvz.v1.Product
+	(*ReceptionWithProducts)(nil),      // 8: pvz.v1.ReceptionWithProducts
+	(*GetPVZListRequest)(nil),          // 9: pvz.v1.GetPVZListRequest
+	(*GetPVZListResponse)(nil),         // 10: pvz.v1.GetPVZListResponse
+	(*GetPVZRequest)(nil),              // 11: pvz.v1.GetPVZRequest
+	(*GetPVZResponse)(nil),             // 12: pvz.v1.GetPVZResponse
+	(*BatchGetPVZRequest)(nil),         // 13: pvz.v1.BatchGetPVZRequest
+	(*BatchGetPVZResponse)(nil),        // 14: pvz.v1.BatchGetPVZResponse
+	(*CreatePVZRequest)(nil),           // 15: pvz.v1.CreatePVZRequest
+	(*CreatePVZResponse)(nil),          // 16: pvz.v1.CreatePVZResponse
+	(*ListReceptionsRequest)(nil),      // 17: pvz.v1.ListReceptionsRequest
+	(*ListReceptionsResponse)(nil),     // 18: pvz.v1.ListReceptionsResponse
+	(*ListProductsRequest)(nil),        // 19: pvz.v1.ListProductsRequest
+	(*ListProductsResponse)(nil),       // 20: pvz.v1.ListProductsResponse
+	(*CreateReceptionRequest)(nil),     // 21: pvz.v1.CreateReceptionRequest
+	(*CreateReceptionResponse)(nil),    // 22: pvz.v1.CreateReceptionResponse
+	(*CloseLastReceptionRequest)(nil),  // 23: pvz.v1.CloseLastReceptionRequest
+	(*CloseLastReceptionResponse)(nil), // 24: pvz.v1.CloseLastReceptionResponse
+	(*AddProductRequest)(nil),          // 25: pvz.v1.AddProductRequest
+	(*AddProductResponse)(nil),         // 26: pvz.v1.AddProductResponse
+	(*DeleteLastProductRequest)(nil),   // 27: pvz.v1.DeleteLastProductRequest
+	(*DeleteLastProductResponse)(nil),  // 28: pvz.v1.DeleteLastProductResponse
+	(*ScanProductsRequest)(nil),        // 29: pvz.v1.ScanProductsRequest
+	(*StartScan)(nil),                  // 30: pvz.v1.StartScan
+	(*ProductScan)(nil),                // 31: pvz.v1.ProductScan
+	(*UndoLastScan)(nil),               // 32: pvz.v1.UndoLastScan
+	(*ScanError)(nil),                  // 33: pvz.v1.ScanError
+	(*ScanProductsResponse)(nil),       // 34: pvz.v1.ScanProductsResponse
+	(*WatchPVZEventsRequest)(nil),      // 35: pvz.v1.WatchPVZEventsRequest
+	(*PVZEvent)(nil),                   // 36: pvz.v1.PVZEvent
+	(*timestamppb.Timestamp)(nil),      // 37: google.protobuf.Timestamp
+}
+var file_api_proto_v1_pvz_proto_depIdxs = []int32{
+	37, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
+	8,  // 1: pvz.v1.PVZ.receptions:type_name -> pvz.v1.ReceptionWithProducts
+	37, // 2: pvz.v1.Reception.date_time:type_name -> google.protobuf.Timestamp
+	0,  // 3: pvz.v1.Reception.status:type_name -> pvz.v1.ReceptionStatus
+	37, // 4: pvz.v1.Product.date_time:type_name -> google.protobuf.Timestamp
+	1,  // 5: pvz.v1.Product.type:type_name -> pvz.v1.ProductType
+	6,  // 6: pvz.v1.ReceptionWithProducts.reception:type_name -> pvz.v1.Reception
+	7,  // 7: pvz.v1.ReceptionWithProducts.products:type_name -> pvz.v1.Product
+	37, // 8: pvz.v1.GetPVZListRequest.start_date:type_name -> google.protobuf.Timestamp
+	37, // 9: pvz.v1.GetPVZListRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,  // 10: pvz.v1.GetPVZListRequest.reception_statuses:type_name -> pvz.v1.ReceptionStatus
+	1,  // 11: pvz.v1.GetPVZListRequest.product_types:type_name -> pvz.v1.ProductType
+	37, // 12: pvz.v1.GetPVZListRequest.registered_from:type_name -> google.protobuf.Timestamp
+	37, // 13: pvz.v1.GetPVZListRequest.registered_to:type_name -> google.protobuf.Timestamp
+	2,  // 14: pvz.v1.GetPVZListRequest.sort_field:type_name -> pvz.v1.PVZSortField
+	5,  // 15: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
+	5,  // 16: pvz.v1.GetPVZResponse.pvz:type_name -> pvz.v1.PVZ
+	5,  // 17: pvz.v1.BatchGetPVZResponse.pvzs:type_name -> pvz.v1.PVZ
+	5,  // 18: pvz.v1.CreatePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	6,  // 19: pvz.v1.ListReceptionsResponse.receptions:type_name -> pvz.v1.Reception
+	7,  // 20: pvz.v1.ListProductsResponse.products:type_name -> pvz.v1.Product
+	6,  // 21: pvz.v1.CreateReceptionResponse.reception:type_name -> pvz.v1.Reception
+	6,  // 22: pvz.v1.CloseLastReceptionResponse.reception:type_name -> pvz.v1.Reception
+	1,  // 23: pvz.v1.AddProductRequest.type:type_name -> pvz.v1.ProductType
+	7,  // 24: pvz.v1.AddProductResponse.product:type_name -> pvz.v1.Product
+	30, // 25: pvz.v1.ScanProductsRequest.start:type_name -> pvz.v1.StartScan
+	31, // 26: pvz.v1.ScanProductsRequest.scan:type_name -> pvz.v1.ProductScan
+	32, // 27: pvz.v1.ScanProductsRequest.undo:type_name -> pvz.v1.UndoLastScan
+	1,  // 28: pvz.v1.ProductScan.type:type_name -> pvz.v1.ProductType
+	3,  // 29: pvz.v1.ScanProductsResponse.action:type_name -> pvz.v1.ScanAction
+	6,  // 30: pvz.v1.ScanProductsResponse.reception:type_name -> pvz.v1.Reception
+	7,  // 31: pvz.v1.ScanProductsResponse.product:type_name -> pvz.v1.Product
+	33, // 32: pvz.v1.ScanProductsResponse.error:type_name -> pvz.v1.ScanError
+	4,  // 33: pvz.v1.PVZEvent.type:type_name -> pvz.v1.PVZEventType
+	37, // 34: pvz.v1.PVZEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	7,  // 35: pvz.v1.PVZEvent.product:type_name -> pvz.v1.Product
+	9,  // 36: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
+	11, // 37: pvz.v1.PVZService.GetPVZ:input_type -> pvz.v1.GetPVZRequest
+	13, // 38: pvz.v1.PVZService.BatchGetPVZ:input_type -> pvz.v1.BatchGetPVZRequest
+	15, // 39: pvz.v1.PVZService.CreatePVZ:input_type -> pvz.v1.CreatePVZRequest
+	21, // 40: pvz.v1.PVZService.CreateReception:input_type -> pvz.v1.CreateReceptionRequest
+	23, // 41: pvz.v1.PVZService.CloseLastReception:input_type -> pvz.v1.CloseLastReceptionRequest
+	17, // 42: pvz.v1.PVZService.ListReceptions:input_type -> pvz.v1.ListReceptionsRequest
+	25, // 43: pvz.v1.PVZService.AddProduct:input_type -> pvz.v1.AddProductRequest
+	27, // 44: pvz.v1.PVZService.DeleteLastProduct:input_type -> pvz.v1.DeleteLastProductRequest
+	19, // 45: pvz.v1.PVZService.ListProducts:input_type -> pvz.v1.ListProductsRequest
+	29, // 46: pvz.v1.PVZService.ScanProducts:input_type -> pvz.v1.ScanProductsRequest
+	35, // 47: pvz.v1.PVZService.WatchPVZEvents:input_type -> pvz.v1.WatchPVZEventsRequest
+	10, // 48: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
+	12, // 49: pvz.v1.PVZService.GetPVZ:output_type -> pvz.v1.GetPVZResponse
+	14, // 50: pvz.v1.PVZService.BatchGetPVZ:output_type -> pvz.v1.BatchGetPVZResponse
+	16, // 51: pvz.v1.PVZService.CreatePVZ:output_type -> pvz.v1.CreatePVZResponse
+	22, // 52: pvz.v1.PVZService.CreateReception:output_type -> pvz.v1.CreateReceptionResponse
+	24, // 53: pvz.v1.PVZService.CloseLastReception:output_type -> pvz.v1.CloseLastReceptionResponse
+	18, // 54: pvz.v1.PVZService.ListReceptions:output_type -> pvz.v1.ListReceptionsResponse
+	26, // 55: pvz.v1.PVZService.AddProduct:output_type -> pvz.v1.AddProductResponse
+	28, // 56: pvz.v1.PVZService.DeleteLastProduct:output_type -> pvz.v1.DeleteLastProductResponse
+	20, // 57: pvz.v1.PVZService.ListProducts:output_type -> pvz.v1.ListProductsResponse
+	34, // 58: pvz.v1.PVZService.ScanProducts:output_type -> pvz.v1.ScanProductsResponse
+	36, // 59: pvz.v1.PVZService.WatchPVZEvents:output_type -> pvz.v1.PVZEvent
+	48, // [48:60] is the sub-list for method output_type
+	36, // [36:48] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_v1_pvz_proto_init() }
+func file_api_proto_v1_pvz_proto_init() {
+	if File_api_proto_v1_pvz_proto != nil {
+		return
+	}
+	file_api_proto_v1_pvz_proto_msgTypes[4].OneofWrappers = []any{}
+	file_api_proto_v1_pvz_proto_msgTypes[5].OneofWrappers = []any{}
+	file_api_proto_v1_pvz_proto_msgTypes[24].OneofWrappers = []any{
+		(*ScanProductsRequest_Start)(nil),
+		(*ScanProductsRequest_Scan)(nil),
+		(*ScanProductsRequest_Undo)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_api_proto_v1_pvz_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_v1_pvz_proto_rawDesc), len(file_api_proto_v1_pvz_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -297,7 +2502,6 @@ func file_api_proto_v1_pvz_proto_init() {
 		MessageInfos:      file_api_proto_v1_pvz_proto_msgTypes,
 	}.Build()
 	File_api_proto_v1_pvz_proto = out.File
-	file_api_proto_v1_pvz_proto_rawDesc = nil
 	file_api_proto_v1_pvz_proto_goTypes = nil
 	file_api_proto_v1_pvz_proto_depIdxs = nil
 }
@@ -19,7 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PVZService_GetPVZList_FullMethodName = "/pvz.v1.PVZService/GetPVZList"
+	PVZService_GetPVZList_FullMethodName         = "/pvz.v1.PVZService/GetPVZList"
+	PVZService_GetPVZ_FullMethodName             = "/pvz.v1.PVZService/GetPVZ"
+	PVZService_BatchGetPVZ_FullMethodName        = "/pvz.v1.PVZService/BatchGetPVZ"
+	PVZService_CreatePVZ_FullMethodName          = "/pvz.v1.PVZService/CreatePVZ"
+	PVZService_CreateReception_FullMethodName    = "/pvz.v1.PVZService/CreateReception"
+	PVZService_CloseLastReception_FullMethodName = "/pvz.v1.PVZService/CloseLastReception"
+	PVZService_ListReceptions_FullMethodName     = "/pvz.v1.PVZService/ListReceptions"
+	PVZService_AddProduct_FullMethodName         = "/pvz.v1.PVZService/AddProduct"
+	PVZService_DeleteLastProduct_FullMethodName  = "/pvz.v1.PVZService/DeleteLastProduct"
+	PVZService_ListProducts_FullMethodName       = "/pvz.v1.PVZService/ListProducts"
+	PVZService_ScanProducts_FullMethodName       = "/pvz.v1.PVZService/ScanProducts"
+	PVZService_WatchPVZEvents_FullMethodName     = "/pvz.v1.PVZService/WatchPVZEvents"
 )
 
 // PVZServiceClient is the client API for PVZService service.
@@ -27,6 +38,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PVZServiceClient interface {
 	GetPVZList(ctx context.Context, in *GetPVZListRequest, opts ...grpc.CallOption) (*GetPVZListResponse, error)
+	GetPVZ(ctx context.Context, in *GetPVZRequest, opts ...grpc.CallOption) (*GetPVZResponse, error)
+	BatchGetPVZ(ctx context.Context, in *BatchGetPVZRequest, opts ...grpc.CallOption) (*BatchGetPVZResponse, error)
+	CreatePVZ(ctx context.Context, in *CreatePVZRequest, opts ...grpc.CallOption) (*CreatePVZResponse, error)
+	CreateReception(ctx context.Context, in *CreateReceptionRequest, opts ...grpc.CallOption) (*CreateReceptionResponse, error)
+	CloseLastReception(ctx context.Context, in *CloseLastReceptionRequest, opts ...grpc.CallOption) (*CloseLastReceptionResponse, error)
+	ListReceptions(ctx context.Context, in *ListReceptionsRequest, opts ...grpc.CallOption) (*ListReceptionsResponse, error)
+	AddProduct(ctx context.Context, in *AddProductRequest, opts ...grpc.CallOption) (*AddProductResponse, error)
+	DeleteLastProduct(ctx context.Context, in *DeleteLastProductRequest, opts ...grpc.CallOption) (*DeleteLastProductResponse, error)
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	ScanProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ScanProductsRequest, ScanProductsResponse], error)
+	WatchPVZEvents(ctx context.Context, in *WatchPVZEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PVZEvent], error)
 }
 
 type pVZServiceClient struct {
@@ -47,11 +69,144 @@ func (c *pVZServiceClient) GetPVZList(ctx context.Context, in *GetPVZListRequest
 	return out, nil
 }
 
+func (c *pVZServiceClient) GetPVZ(ctx context.Context, in *GetPVZRequest, opts ...grpc.CallOption) (*GetPVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetPVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) BatchGetPVZ(ctx context.Context, in *BatchGetPVZRequest, opts ...grpc.CallOption) (*BatchGetPVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetPVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_BatchGetPVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreatePVZ(ctx context.Context, in *CreatePVZRequest, opts ...grpc.CallOption) (*CreatePVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreatePVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreateReception(ctx context.Context, in *CreateReceptionRequest, opts ...grpc.CallOption) (*CreateReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreateReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CloseLastReception(ctx context.Context, in *CloseLastReceptionRequest, opts ...grpc.CallOption) (*CloseLastReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseLastReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_CloseLastReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListReceptions(ctx context.Context, in *ListReceptionsRequest, opts ...grpc.CallOption) (*ListReceptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReceptionsResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListReceptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) AddProduct(ctx context.Context, in *AddProductRequest, opts ...grpc.CallOption) (*AddProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_AddProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) DeleteLastProduct(ctx context.Context, in *DeleteLastProductRequest, opts ...grpc.CallOption) (*DeleteLastProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLastProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeleteLastProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductsResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ScanProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ScanProductsRequest, ScanProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PVZService_ServiceDesc.Streams[0], PVZService_ScanProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ScanProductsRequest, ScanProductsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_ScanProductsClient = grpc.BidiStreamingClient[ScanProductsRequest, ScanProductsResponse]
+
+func (c *pVZServiceClient) WatchPVZEvents(ctx context.Context, in *WatchPVZEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PVZEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PVZService_ServiceDesc.Streams[1], PVZService_WatchPVZEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPVZEventsRequest, PVZEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_WatchPVZEventsClient = grpc.ServerStreamingClient[PVZEvent]
+
 // PVZServiceServer is the server API for PVZService service.
 // All implementations must embed UnimplementedPVZServiceServer
 // for forward compatibility.
 type PVZServiceServer interface {
 	GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error)
+	GetPVZ(context.Context, *GetPVZRequest) (*GetPVZResponse, error)
+	BatchGetPVZ(context.Context, *BatchGetPVZRequest) (*BatchGetPVZResponse, error)
+	CreatePVZ(context.Context, *CreatePVZRequest) (*CreatePVZResponse, error)
+	CreateReception(context.Context, *CreateReceptionRequest) (*CreateReceptionResponse, error)
+	CloseLastReception(context.Context, *CloseLastReceptionRequest) (*CloseLastReceptionResponse, error)
+	ListReceptions(context.Context, *ListReceptionsRequest) (*ListReceptionsResponse, error)
+	AddProduct(context.Context, *AddProductRequest) (*AddProductResponse, error)
+	DeleteLastProduct(context.Context, *DeleteLastProductRequest) (*DeleteLastProductResponse, error)
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	ScanProducts(grpc.BidiStreamingServer[ScanProductsRequest, ScanProductsResponse]) error
+	WatchPVZEvents(*WatchPVZEventsRequest, grpc.ServerStreamingServer[PVZEvent]) error
 	mustEmbedUnimplementedPVZServiceServer()
 }
 
@@ -65,6 +220,39 @@ type UnimplementedPVZServiceServer struct{}
 func (UnimplementedPVZServiceServer) GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPVZList not implemented")
 }
+func (UnimplementedPVZServiceServer) GetPVZ(context.Context, *GetPVZRequest) (*GetPVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) BatchGetPVZ(context.Context, *BatchGetPVZRequest) (*BatchGetPVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetPVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) CreatePVZ(context.Context, *CreatePVZRequest) (*CreatePVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) CreateReception(context.Context, *CreateReceptionRequest) (*CreateReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReception not implemented")
+}
+func (UnimplementedPVZServiceServer) CloseLastReception(context.Context, *CloseLastReceptionRequest) (*CloseLastReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseLastReception not implemented")
+}
+func (UnimplementedPVZServiceServer) ListReceptions(context.Context, *ListReceptionsRequest) (*ListReceptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReceptions not implemented")
+}
+func (UnimplementedPVZServiceServer) AddProduct(context.Context, *AddProductRequest) (*AddProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) DeleteLastProduct(context.Context, *DeleteLastProductRequest) (*DeleteLastProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLastProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedPVZServiceServer) ScanProducts(grpc.BidiStreamingServer[ScanProductsRequest, ScanProductsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ScanProducts not implemented")
+}
+func (UnimplementedPVZServiceServer) WatchPVZEvents(*WatchPVZEventsRequest, grpc.ServerStreamingServer[PVZEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPVZEvents not implemented")
+}
 func (UnimplementedPVZServiceServer) mustEmbedUnimplementedPVZServiceServer() {}
 func (UnimplementedPVZServiceServer) testEmbeddedByValue()                    {}
 
@@ -104,6 +292,186 @@ func _PVZService_GetPVZList_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PVZService_GetPVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetPVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetPVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetPVZ(ctx, req.(*GetPVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_BatchGetPVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetPVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).BatchGetPVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_BatchGetPVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).BatchGetPVZ(ctx, req.(*BatchGetPVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CreatePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreatePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreatePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreatePVZ(ctx, req.(*CreatePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CreateReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreateReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreateReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreateReception(ctx, req.(*CreateReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CloseLastReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseLastReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CloseLastReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CloseLastReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CloseLastReception(ctx, req.(*CloseLastReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListReceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListReceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListReceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListReceptions(ctx, req.(*ListReceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_AddProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).AddProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_AddProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).AddProduct(ctx, req.(*AddProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_DeleteLastProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLastProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeleteLastProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeleteLastProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeleteLastProduct(ctx, req.(*DeleteLastProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ScanProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PVZServiceServer).ScanProducts(&grpc.GenericServerStream[ScanProductsRequest, ScanProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_ScanProductsServer = grpc.BidiStreamingServer[ScanProductsRequest, ScanProductsResponse]
+
+func _PVZService_WatchPVZEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPVZEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PVZServiceServer).WatchPVZEvents(m, &grpc.GenericServerStream[WatchPVZEventsRequest, PVZEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_WatchPVZEventsServer = grpc.ServerStreamingServer[PVZEvent]
+
 // PVZService_ServiceDesc is the grpc.ServiceDesc for PVZService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,7 +483,55 @@ var PVZService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPVZList",
 			Handler:    _PVZService_GetPVZList_Handler,
 		},
+		{
+			MethodName: "GetPVZ",
+			Handler:    _PVZService_GetPVZ_Handler,
+		},
+		{
+			MethodName: "BatchGetPVZ",
+			Handler:    _PVZService_BatchGetPVZ_Handler,
+		},
+		{
+			MethodName: "CreatePVZ",
+			Handler:    _PVZService_CreatePVZ_Handler,
+		},
+		{
+			MethodName: "CreateReception",
+			Handler:    _PVZService_CreateReception_Handler,
+		},
+		{
+			MethodName: "CloseLastReception",
+			Handler:    _PVZService_CloseLastReception_Handler,
+		},
+		{
+			MethodName: "ListReceptions",
+			Handler:    _PVZService_ListReceptions_Handler,
+		},
+		{
+			MethodName: "AddProduct",
+			Handler:    _PVZService_AddProduct_Handler,
+		},
+		{
+			MethodName: "DeleteLastProduct",
+			Handler:    _PVZService_DeleteLastProduct_Handler,
+		},
+		{
+			MethodName: "ListProducts",
+			Handler:    _PVZService_ListProducts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ScanProducts",
+			Handler:       _PVZService_ScanProducts_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchPVZEvents",
+			Handler:       _PVZService_WatchPVZEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/v1/pvz.proto",
 }
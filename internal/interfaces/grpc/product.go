@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+
+	domainProduct "avito/internal/domain/product"
+	domainPVZ "avito/internal/domain/pvz"
+	pbpvz "avito/internal/interfaces/grpc/pb"
+	"avito/internal/metrics"
+)
+
+func (s *pvzServiceServer) AddProduct(ctx context.Context, req *pbpvz.AddProductRequest) (*pbpvz.AddProductResponse, error) {
+	newProduct, err := s.productService.AddProduct(ctx, req.GetPvzId(), productTypeFromProto(req.GetType()))
+	if err != nil {
+		s.logger.Error("Ошибка при добавлении товара", "error", err, "pvzID", req.GetPvzId())
+		return nil, err
+	}
+
+	metrics.ProductsAddedTotal.Inc()
+
+	return &pbpvz.AddProductResponse{
+		Product: productToProto(newProduct),
+	}, nil
+}
+
+func (s *pvzServiceServer) DeleteLastProduct(ctx context.Context,
+	req *pbpvz.DeleteLastProductRequest) (*pbpvz.DeleteLastProductResponse, error) {
+	if err := s.productService.DeleteLastProduct(ctx, req.GetPvzId()); err != nil {
+		s.logger.Error("Ошибка при удалении товара", "error", err, "pvzID", req.GetPvzId())
+		return nil, err
+	}
+
+	return &pbpvz.DeleteLastProductResponse{}, nil
+}
+
+func (s *pvzServiceServer) ListProducts(ctx context.Context, req *pbpvz.ListProductsRequest) (*pbpvz.ListProductsResponse, error) {
+	listReq, err := listProductsRequestFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.productService.ListProducts(ctx, listReq)
+	if err != nil {
+		s.logger.Error("Ошибка при получении товаров приемки", "error", err, "receptionID", req.GetReceptionId())
+		return nil, err
+	}
+
+	response := &pbpvz.ListProductsResponse{
+		Products: make([]*pbpvz.Product, 0, len(page.Items)),
+	}
+
+	for i := range page.Items {
+		response.Products = append(response.Products, productToProto(&page.Items[i]))
+	}
+
+	if page.Next != nil {
+		response.NextPageToken = page.Next.Encode()
+	}
+
+	return response, nil
+}
+
+func listProductsRequestFromProto(req *pbpvz.ListProductsRequest) (domainProduct.ListRequest, error) {
+	receptionID, err := parseReceptionID(req.GetReceptionId())
+	if err != nil {
+		return domainProduct.ListRequest{}, err
+	}
+
+	listReq := domainProduct.ListRequest{
+		ReceptionID: receptionID,
+		Limit:       domainProduct.DefaultPageSize,
+	}
+
+	if req.GetLimit() != 0 {
+		if req.GetLimit() < 1 || req.GetLimit() > domainProduct.MaxPageSize {
+			return domainProduct.ListRequest{}, &domainPVZ.ErrInvalidPaginationParams{}
+		}
+
+		listReq.Limit = int(req.GetLimit())
+	}
+
+	if req.GetPageToken() != "" {
+		cursor, err := domainProduct.DecodeCursor(req.GetPageToken())
+		if err != nil {
+			return domainProduct.ListRequest{}, err
+		}
+
+		listReq.After = cursor
+	}
+
+	return listReq, nil
+}
@@ -3,47 +3,264 @@ package grpc
 import (
 	"context"
 	"log/slog"
-	"time"
+	"slices"
 
+	domainProduct "avito/internal/domain/product"
 	domainPVZ "avito/internal/domain/pvz"
+	domainReception "avito/internal/domain/reception"
 	pbpvz "avito/internal/interfaces/grpc/pb"
+	"avito/internal/metrics"
 
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPage = 1
+
+	includeReceptions = "receptions"
+	includeProducts   = "products"
+	includeNone       = "none"
 )
 
 type pvzServiceServer struct {
 	pbpvz.UnimplementedPVZServiceServer
-	pvzService PVZService
-	logger     *slog.Logger
+	pvzService       PVZService
+	receptionService ReceptionService
+	productService   ProductService
+	eventSubscriber  EventSubscriber
+	logger           *slog.Logger
+	shutdown         <-chan struct{}
 }
 
-func (s *pvzServiceServer) GetPVZList(ctx context.Context, _ *pbpvz.GetPVZListRequest) (*pbpvz.GetPVZListResponse, error) {
-	pvzReq := domainPVZ.GetPVZsRequest{
-		Page:  1,
-		Limit: 100,
+func (s *pvzServiceServer) GetPVZList(ctx context.Context, req *pbpvz.GetPVZListRequest) (*pbpvz.GetPVZListResponse, error) {
+	pvzReq, err := getPVZsRequestFromProto(req)
+	if err != nil {
+		return nil, err
 	}
 
-	pvzList, err := s.pvzService.GetPVZs(ctx, pvzReq)
+	page, err := s.pvzService.GetPVZs(ctx, pvzReq)
 	if err != nil {
 		s.logger.Error("Ошибка при получении списка ПВЗ", "error", err)
 		return nil, err
 	}
 
 	response := &pbpvz.GetPVZListResponse{
-		Pvzs: make([]*pbpvz.PVZ, 0, len(pvzList)),
+		Pvzs: make([]*pbpvz.PVZ, 0, len(page.Items)),
+	}
+
+	for i := range page.Items {
+		response.Pvzs = append(response.Pvzs, pvzWithReceptionsToProto(&page.Items[i]))
+	}
+
+	if page.Next != nil {
+		response.NextPageToken = page.Next.Encode()
+	}
+
+	if page.Total != nil {
+		//nolint:gosec // количество ПВЗ не превышает int32
+		total := int32(*page.Total)
+		response.TotalCount = &total
+	}
+
+	return response, nil
+}
+
+func (s *pvzServiceServer) GetPVZ(ctx context.Context, req *pbpvz.GetPVZRequest) (*pbpvz.GetPVZResponse, error) {
+	item, err := s.pvzService.GetPVZWithReceptions(ctx, req.GetId())
+	if err != nil {
+		s.logger.Error("Ошибка при получении ПВЗ", "error", err, "pvzID", req.GetId())
+
+		return nil, err
+	}
+
+	return &pbpvz.GetPVZResponse{
+		Pvz: pvzWithReceptionsToProto(item),
+	}, nil
+}
+
+func (s *pvzServiceServer) BatchGetPVZ(ctx context.Context, req *pbpvz.BatchGetPVZRequest) (*pbpvz.BatchGetPVZResponse, error) {
+	ids := make([]uuid.UUID, 0, len(req.GetIds()))
+
+	for _, id := range req.GetIds() {
+		pvzID, err := parsePVZID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, pvzID)
+	}
+
+	items, err := s.pvzService.GetPVZsByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error("Ошибка при получении списка ПВЗ по ID", "error", err)
+
+		return nil, err
+	}
+
+	response := &pbpvz.BatchGetPVZResponse{
+		Pvzs: make([]*pbpvz.PVZ, 0, len(items)),
 	}
 
-	for _, item := range pvzList {
-		response.Pvzs = append(response.Pvzs, &pbpvz.PVZ{
-			Id:               item.PVZ.ID.String(),
-			RegistrationDate: timestampFromTime(item.PVZ.RegistrationDate),
-			City:             string(item.PVZ.City),
-		})
+	found := make(map[string]struct{}, len(items))
+
+	for i := range items {
+		found[items[i].PVZ.ID.String()] = struct{}{}
+		response.Pvzs = append(response.Pvzs, pvzWithReceptionsToProto(&items[i]))
+	}
+
+	// ID сравниваются в каноническом виде, так как клиент мог передать их в другом регистре.
+	for _, id := range ids {
+		canonicalID := id.String()
+		if _, ok := found[canonicalID]; ok {
+			continue
+		}
+
+		found[canonicalID] = struct{}{}
+		response.NotFoundIds = append(response.NotFoundIds, canonicalID)
 	}
 
 	return response, nil
 }
 
-func timestampFromTime(t time.Time) *timestamppb.Timestamp {
-	return timestamppb.New(t)
+func (s *pvzServiceServer) CreatePVZ(ctx context.Context, req *pbpvz.CreatePVZRequest) (*pbpvz.CreatePVZResponse, error) {
+	newPVZ, err := s.pvzService.CreatePVZ(ctx, req.GetCity())
+	if err != nil {
+		s.logger.Error("Ошибка при создании ПВЗ", "error", err)
+		return nil, err
+	}
+
+	metrics.PVZCreatedTotal.Inc()
+
+	return &pbpvz.CreatePVZResponse{
+		Pvz: pvzToProto(newPVZ),
+	}, nil
+}
+
+func getPVZsRequestFromProto(req *pbpvz.GetPVZListRequest) (domainPVZ.GetPVZsRequest, error) {
+	pvzReq := domainPVZ.GetPVZsRequest{
+		Page:      defaultPage,
+		Limit:     domainPVZ.DefaultPageSize,
+		WithTotal: req.GetWithTotal(),
+	}
+
+	if req.GetPage() != 0 {
+		if req.GetPage() < 1 {
+			return domainPVZ.GetPVZsRequest{}, &domainPVZ.ErrInvalidPaginationParams{}
+		}
+
+		pvzReq.Page = int(req.GetPage())
+	}
+
+	if req.GetLimit() != 0 {
+		if req.GetLimit() < 1 || req.GetLimit() > domainPVZ.MaxPageSize {
+			return domainPVZ.GetPVZsRequest{}, &domainPVZ.ErrInvalidPaginationParams{}
+		}
+
+		pvzReq.Limit = int(req.GetLimit())
+	}
+
+	if req.GetPageToken() != "" {
+		cursor, err := domainPVZ.DecodeCursor(req.GetPageToken())
+		if err != nil {
+			return domainPVZ.GetPVZsRequest{}, err
+		}
+
+		pvzReq.After = cursor
+	}
+
+	if err := filtersFromProto(req, &pvzReq); err != nil {
+		return domainPVZ.GetPVZsRequest{}, err
+	}
+
+	nested, err := nestedFromProto(req)
+	if err != nil {
+		return domainPVZ.GetPVZsRequest{}, err
+	}
+
+	pvzReq.Nested = nested
+
+	return pvzReq, nil
+}
+
+// filtersFromProto переносит фильтры и сортировку в запрос так же, как параметры HTTP API.
+func filtersFromProto(req *pbpvz.GetPVZListRequest, pvzReq *domainPVZ.GetPVZsRequest) error {
+	cities := req.GetCities()
+	if req.GetCity() != "" {
+		cities = append([]string{req.GetCity()}, cities...)
+	}
+
+	for _, name := range cities {
+		city := domainPVZ.City(name)
+		if !city.Validate() {
+			return &domainPVZ.ErrInvalidCity{}
+		}
+
+		pvzReq.Cities = append(pvzReq.Cities, city)
+	}
+
+	for _, status := range req.GetReceptionStatuses() {
+		pvzReq.ReceptionStatuses = append(pvzReq.ReceptionStatuses, receptionStatusFromProto(status))
+	}
+
+	for _, productType := range req.GetProductTypes() {
+		pvzReq.ProductTypes = append(pvzReq.ProductTypes, productTypeFromProto(productType))
+	}
+
+	pvzReq.HasOpenReception = req.HasOpenReception
+	pvzReq.StartDate = timeFromProto(req.GetStartDate())
+	pvzReq.EndDate = timeFromProto(req.GetEndDate())
+	pvzReq.RegisteredFrom = timeFromProto(req.GetRegisteredFrom())
+	pvzReq.RegisteredTo = timeFromProto(req.GetRegisteredTo())
+
+	switch req.GetSortField() {
+	case pbpvz.PVZSortField_PVZ_SORT_FIELD_RECEPTION_COUNT:
+		pvzReq.Sort.Field = domainPVZ.SortReceptionCount
+	case pbpvz.PVZSortField_PVZ_SORT_FIELD_LAST_ACTIVITY:
+		pvzReq.Sort.Field = domainPVZ.SortLastActivity
+	case pbpvz.PVZSortField_PVZ_SORT_FIELD_REGISTRATION_DATE:
+		pvzReq.Sort.Field = domainPVZ.SortRegistrationDate
+	default:
+		return &domainPVZ.ValidationError{Key: domainPVZ.DetailUnknownSortField}
+	}
+
+	pvzReq.Sort.Asc = req.GetAscending()
+
+	return nil
+}
+
+// nestedFromProto разбирает include и лимиты вложенных данных так же, как параметры HTTP API.
+func nestedFromProto(req *pbpvz.GetPVZListRequest) (domainPVZ.Nested, error) {
+	var nested domainPVZ.Nested
+
+	if len(req.GetInclude()) > 0 {
+		for _, item := range req.GetInclude() {
+			if item != includeReceptions && item != includeProducts && item != includeNone {
+				return domainPVZ.Nested{}, &domainPVZ.ValidationError{
+					Key:    domainPVZ.DetailUnknownInclude,
+					Params: map[string]string{"value": item},
+				}
+			}
+		}
+
+		if slices.Contains(req.GetInclude(), includeNone) && len(req.GetInclude()) > 1 {
+			return domainPVZ.Nested{}, &domainPVZ.ValidationError{Key: domainPVZ.DetailIncludeNoneCombined}
+		}
+
+		nested.OmitReceptions = !slices.Contains(req.GetInclude(), includeReceptions)
+		nested.OmitProducts = !slices.Contains(req.GetInclude(), includeProducts)
+
+		if nested.OmitReceptions && !nested.OmitProducts {
+			return domainPVZ.Nested{}, &domainPVZ.ValidationError{Key: domainPVZ.DetailProductsWithoutReceptions}
+		}
+	}
+
+	if req.GetReceptionsLimit() < 0 || req.GetReceptionsLimit() > domainReception.MaxPageSize ||
+		req.GetProductsLimit() < 0 || req.GetProductsLimit() > domainProduct.MaxPageSize {
+		return domainPVZ.Nested{}, &domainPVZ.ErrInvalidPaginationParams{}
+	}
+
+	nested.ReceptionsLimit = int(req.GetReceptionsLimit())
+	nested.ProductsLimit = int(req.GetProductsLimit())
+
+	return nested, nil
 }
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+
+	domainPVZ "avito/internal/domain/pvz"
+	domainReception "avito/internal/domain/reception"
+	pbpvz "avito/internal/interfaces/grpc/pb"
+	"avito/internal/metrics"
+)
+
+func (s *pvzServiceServer) CreateReception(ctx context.Context,
+	req *pbpvz.CreateReceptionRequest) (*pbpvz.CreateReceptionResponse, error) {
+	rec, err := s.receptionService.CreateReception(ctx, req.GetPvzId())
+	if err != nil {
+		s.logger.Error("Ошибка при создании приемки", "error", err, "pvzID", req.GetPvzId())
+		return nil, err
+	}
+
+	metrics.ReceptionsCreatedTotal.Inc()
+
+	return &pbpvz.CreateReceptionResponse{
+		Reception: receptionToProto(rec),
+	}, nil
+}
+
+func (s *pvzServiceServer) CloseLastReception(ctx context.Context,
+	req *pbpvz.CloseLastReceptionRequest) (*pbpvz.CloseLastReceptionResponse, error) {
+	rec, err := s.receptionService.CloseLastReception(ctx, req.GetPvzId())
+	if err != nil {
+		s.logger.Error("Ошибка при закрытии приемки", "error", err, "pvzID", req.GetPvzId())
+		return nil, err
+	}
+
+	return &pbpvz.CloseLastReceptionResponse{
+		Reception: receptionToProto(rec),
+	}, nil
+}
+
+func (s *pvzServiceServer) ListReceptions(ctx context.Context,
+	req *pbpvz.ListReceptionsRequest) (*pbpvz.ListReceptionsResponse, error) {
+	listReq, err := listReceptionsRequestFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.receptionService.ListReceptions(ctx, listReq)
+	if err != nil {
+		s.logger.Error("Ошибка при получении приемок ПВЗ", "error", err, "pvzID", req.GetPvzId())
+		return nil, err
+	}
+
+	response := &pbpvz.ListReceptionsResponse{
+		Receptions: make([]*pbpvz.Reception, 0, len(page.Items)),
+	}
+
+	for i := range page.Items {
+		response.Receptions = append(response.Receptions, receptionToProto(&page.Items[i]))
+	}
+
+	if page.Next != nil {
+		response.NextPageToken = page.Next.Encode()
+	}
+
+	return response, nil
+}
+
+func listReceptionsRequestFromProto(req *pbpvz.ListReceptionsRequest) (domainReception.ListRequest, error) {
+	pvzID, err := parsePVZID(req.GetPvzId())
+	if err != nil {
+		return domainReception.ListRequest{}, err
+	}
+
+	listReq := domainReception.ListRequest{
+		PVZID: pvzID,
+		Limit: domainReception.DefaultPageSize,
+	}
+
+	if req.GetLimit() != 0 {
+		if req.GetLimit() < 1 || req.GetLimit() > domainReception.MaxPageSize {
+			return domainReception.ListRequest{}, &domainPVZ.ErrInvalidPaginationParams{}
+		}
+
+		listReq.Limit = int(req.GetLimit())
+	}
+
+	if req.GetPageToken() != "" {
+		cursor, err := domainReception.DecodeCursor(req.GetPageToken())
+		if err != nil {
+			return domainReception.ListRequest{}, err
+		}
+
+		listReq.After = cursor
+	}
+
+	return listReq, nil
+}
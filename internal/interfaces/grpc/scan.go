@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"errors"
+	"io"
+
+	domainProduct "avito/internal/domain/product"
+	domainReception "avito/internal/domain/reception"
+	"avito/internal/i18n"
+	pbpvz "avito/internal/interfaces/grpc/pb"
+	"avito/internal/metrics"
+
+	"google.golang.org/grpc"
+)
+
+type scanStream = grpc.BidiStreamingServer[pbpvz.ScanProductsRequest, pbpvz.ScanProductsResponse]
+
+func (s *pvzServiceServer) ScanProducts(stream scanStream) error {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return recvError(err)
+	}
+
+	start := req.GetStart()
+	if start == nil {
+		return &domainProduct.ValidationError{Key: domainProduct.DetailScanStartExpected}
+	}
+
+	activeReception, err := s.productService.OpenScanSession(ctx, start.GetPvzId())
+	if err != nil {
+		s.logger.Error("Ошибка при открытии сессии сканирования", "error", err, "pvzID", start.GetPvzId())
+		return err
+	}
+
+	err = stream.Send(&pbpvz.ScanProductsResponse{
+		Action:    pbpvz.ScanAction_SCAN_ACTION_STARTED,
+		Reception: receptionToProto(activeReception),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return recvError(err)
+		}
+
+		resp, err := s.handleScanCommand(stream, activeReception, req)
+		if err != nil {
+			if !isRecoverableScanError(err) {
+				s.logger.Error("Ошибка при сканировании товаров", "error", err, "receptionID", activeReception.ID)
+				return err
+			}
+
+			_, reason, detail := mapError(err)
+			resp.Error = &pbpvz.ScanError{
+				Reason:  string(reason),
+				Message: i18n.Message(i18n.FromContext(stream.Context()), reason, detail),
+			}
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// handleScanCommand всегда возвращает ответ с действием, чтобы при восстановимой ошибке
+// клиент получил ack на свою команду.
+func (s *pvzServiceServer) handleScanCommand(stream scanStream, activeReception *domainReception.Reception,
+	req *pbpvz.ScanProductsRequest) (*pbpvz.ScanProductsResponse, error) {
+	ctx := stream.Context()
+
+	switch cmd := req.GetCommand().(type) {
+	case *pbpvz.ScanProductsRequest_Scan:
+		resp := &pbpvz.ScanProductsResponse{Action: pbpvz.ScanAction_SCAN_ACTION_ADDED}
+
+		newProduct, err := s.productService.AddProductToReception(ctx, activeReception, productTypeFromProto(cmd.Scan.GetType()))
+		if err != nil {
+			return resp, err
+		}
+
+		metrics.ProductsAddedTotal.Inc()
+
+		resp.SequenceNumber = int64(newProduct.SequenceNumber)
+		resp.Product = productToProto(newProduct)
+
+		return resp, nil
+	case *pbpvz.ScanProductsRequest_Undo:
+		resp := &pbpvz.ScanProductsResponse{Action: pbpvz.ScanAction_SCAN_ACTION_UNDONE}
+
+		deletedProduct, err := s.productService.DeleteLastProductFromReception(ctx, activeReception)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.SequenceNumber = int64(deletedProduct.SequenceNumber)
+		resp.Product = productToProto(deletedProduct)
+
+		return resp, nil
+	case *pbpvz.ScanProductsRequest_Start:
+		return nil, &domainProduct.ValidationError{Key: domainProduct.DetailScanAlreadyStarted}
+	default:
+		return nil, &domainProduct.ValidationError{Key: domainProduct.DetailUnknownScanCommand}
+	}
+}
+
+// isRecoverableScanError сообщает, относится ли ошибка только к одной команде.
+// Такие ошибки возвращаются в ack, а стрим продолжает работу.
+func isRecoverableScanError(err error) bool {
+	var (
+		typeEmptyErr   *domainProduct.ErrTypeEmpty
+		invalidTypeErr *domainProduct.ErrInvalidProductType
+		noProductsErr  *domainProduct.ErrNoProductsToDelete
+	)
+
+	return errors.As(err, &typeEmptyErr) || errors.As(err, &invalidTypeErr) || errors.As(err, &noProductsErr)
+}
+
+// recvError завершает стрим без ошибки, когда клиент закрыл отправку.
+func recvError(err error) error {
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	return err
+}
@@ -4,32 +4,92 @@ import (
 	"context"
 	"log/slog"
 	"net"
+	"sync"
 
+	"avito/internal/domain/product"
 	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
 	pbpvz "avito/internal/interfaces/grpc/pb"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 type PVZService interface {
+	CreatePVZ(ctx context.Context, city string) (*pvz.PVZ, error)
 	GetPVZByID(ctx context.Context, id string) (*pvz.PVZ, error)
-	GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) ([]pvz.WithReceptions, error)
+	GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) (*pvz.Page, error)
+	GetPVZWithReceptions(ctx context.Context, id string) (*pvz.WithReceptions, error)
+	GetPVZsByIDs(ctx context.Context, ids []uuid.UUID) ([]pvz.WithReceptions, error)
 }
 
-type Server struct {
-	server     *grpc.Server
-	pvzService PVZService
-	logger     *slog.Logger
+type ReceptionService interface {
+	CreateReception(ctx context.Context, pvzID string) (*reception.Reception, error)
+	CloseLastReception(ctx context.Context, pvzID string) (*reception.Reception, error)
+	ListReceptions(ctx context.Context, req reception.ListRequest) (*reception.Page, error)
+}
+
+type ProductService interface {
+	AddProduct(ctx context.Context, pvzID string, productType product.Type) (*product.Product, error)
+	DeleteLastProduct(ctx context.Context, pvzID string) error
+	ListProducts(ctx context.Context, req product.ListRequest) (*product.Page, error)
+	OpenScanSession(ctx context.Context, pvzID string) (*reception.Reception, error)
+	AddProductToReception(ctx context.Context, activeReception *reception.Reception, productType product.Type) (*product.Product, error)
+	DeleteLastProductFromReception(ctx context.Context, activeReception *reception.Reception) (*product.Product, error)
 }
 
-func New(domainPVZService DomainPVZService, logger *slog.Logger) *Server {
-	pvzService := NewPVZServiceAdapter(domainPVZService)
+type Server struct {
+	server           *grpc.Server
+	pvzService       PVZService
+	receptionService ReceptionService
+	productService   ProductService
+	eventSubscriber  EventSubscriber
+	health           *DatabaseHealth
+	logger           *slog.Logger
+	// shutdown закрывается в Stop, чтобы бесконечные стримы событий завершились до GracefulStop.
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+}
 
+func New(
+	domainPVZService DomainPVZService,
+	domainReceptionService DomainReceptionService,
+	domainProductService DomainProductService,
+	eventSubscriber EventSubscriber,
+	health *DatabaseHealth,
+	tokenParser TokenParser,
+	logger *slog.Logger,
+) *Server {
 	server := &Server{
-		server:     grpc.NewServer(),
-		pvzService: pvzService,
-		logger:     logger,
+		server: grpc.NewServer(
+			// Логирование и метрики снаружи, чтобы видеть итоговый код ответа,
+			// включая ошибки авторизации и восстановленные паники.
+			grpc.ChainUnaryInterceptor(
+				LoggingUnaryInterceptor(logger),
+				MetricsUnaryInterceptor(),
+				LocalizationUnaryInterceptor(),
+				RecoveryUnaryInterceptor(logger),
+				ErrorUnaryInterceptor(),
+				AuthUnaryInterceptor(tokenParser),
+			),
+			grpc.ChainStreamInterceptor(
+				LoggingStreamInterceptor(logger),
+				MetricsStreamInterceptor(),
+				LocalizationStreamInterceptor(),
+				RecoveryStreamInterceptor(logger),
+				ErrorStreamInterceptor(),
+				AuthStreamInterceptor(tokenParser),
+			),
+		),
+		pvzService:       NewPVZServiceAdapter(domainPVZService),
+		receptionService: NewReceptionServiceAdapter(domainReceptionService),
+		productService:   NewProductServiceAdapter(domainProductService),
+		eventSubscriber:  eventSubscriber,
+		health:           health,
+		logger:           logger,
+		shutdown:         make(chan struct{}),
 	}
 
 	return server
@@ -42,10 +102,15 @@ func (s *Server) Start(addr string) error {
 	}
 
 	pbpvz.RegisterPVZServiceServer(s.server, &pvzServiceServer{
-		pvzService: s.pvzService,
-		logger:     s.logger,
+		pvzService:       s.pvzService,
+		receptionService: s.receptionService,
+		productService:   s.productService,
+		eventSubscriber:  s.eventSubscriber,
+		logger:           s.logger,
+		shutdown:         s.shutdown,
 	})
 
+	healthpb.RegisterHealthServer(s.server, s.health)
 	reflection.Register(s.server)
 
 	s.logger.Info("Запуск gRPC-сервера", "addr", addr)
@@ -53,7 +118,24 @@ func (s *Server) Start(addr string) error {
 	return s.server.Serve(lis)
 }
 
-func (s *Server) Stop() {
+// Stop завершает стримы событий и дожидается окончания остальных вызовов, пока не истечет ctx.
+// После этого оставшиеся соединения, например открытые сессии сканирования, закрываются принудительно.
+func (s *Server) Stop(ctx context.Context) {
 	s.logger.Info("Остановка gRPC-сервера")
-	s.server.GracefulStop()
+	s.shutdownOnce.Do(func() { close(s.shutdown) })
+
+	stopped := make(chan struct{})
+
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.logger.Warn("gRPC-сервер не остановился за отведенное время, соединения закрываются принудительно")
+		s.server.Stop()
+		<-stopped
+	}
 }
@@ -0,0 +1,138 @@
+package grpc_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"avito/internal/domain/auth"
+	"avito/internal/domain/event"
+	"avito/internal/domain/product"
+	"avito/internal/domain/reception"
+	"avito/internal/infrastructure/events"
+	grpcServer "avito/internal/interfaces/grpc"
+	pbpvz "avito/internal/interfaces/grpc/pb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// stubScanService открывает сессию сканирования; остальные методы в тестах остановки не вызываются.
+type stubScanService struct {
+	grpcServer.DomainProductService
+}
+
+func (s *stubScanService) OpenScanSession(_ context.Context, pvzID uuid.UUID) (*reception.Reception, error) {
+	return &reception.Reception{ID: uuid.New(), PVZID: pvzID, Status: reception.StatusInProgress}, nil
+}
+
+func (s *stubScanService) AddProductToReception(context.Context, *reception.Reception, product.Type) (*product.Product, error) {
+	return &product.Product{}, nil
+}
+
+// startServer запускает gRPC-сервер на свободном порту и возвращает клиента с токеном сотрудника.
+func startServer(t *testing.T, broker *events.Broker) (*grpcServer.Server, pbpvz.PVZServiceClient) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	health := grpcServer.NewDatabaseHealth(&stubPinger{}, time.Second, logger)
+	parser := &stubTokenParser{userID: uuid.New(), role: auth.RoleEmployee}
+
+	srv := grpcServer.New(nil, nil, &stubScanService{}, broker, health, parser, logger)
+
+	go func() {
+		_ = srv.Start(addr)
+	}()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return srv, pbpvz.NewPVZServiceClient(conn)
+}
+
+func authorized() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+}
+
+func TestServer_StopClosesEventStreams(t *testing.T) {
+	broker := events.NewBroker(events.DefaultHistorySize, events.DefaultSubscriberSize)
+	srv, client := startServer(t, broker)
+
+	for range 2 {
+		broker.Publish(context.Background(), event.Event{Type: event.TypeReceptionCreated, PVZID: uuid.New()})
+	}
+
+	var stream grpc.ServerStreamingClient[pbpvz.PVZEvent]
+
+	require.Eventually(t, func() bool {
+		var err error
+		stream, err = client.WatchPVZEvents(authorized(), &pbpvz.WatchPVZEventsRequest{AfterSequence: 1})
+
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	// Событие из истории подтверждает, что стрим подписан и ждет новых событий.
+	_, err := stream.Recv()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	srv.Stop(ctx)
+	assert.Less(t, time.Since(start), time.Second, "стрим событий не должен задерживать остановку")
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestServer_StopForcesAfterTimeout(t *testing.T) {
+	srv, client := startServer(t, events.NewBroker(0, 0))
+
+	var stream grpc.BidiStreamingClient[pbpvz.ScanProductsRequest, pbpvz.ScanProductsResponse]
+
+	require.Eventually(t, func() bool {
+		var err error
+		stream, err = client.ScanProducts(authorized())
+		if err != nil {
+			return false
+		}
+
+		err = stream.Send(&pbpvz.ScanProductsRequest{Command: &pbpvz.ScanProductsRequest_Start{
+			Start: &pbpvz.StartScan{PvzId: uuid.NewString()},
+		}})
+		if err != nil {
+			return false
+		}
+
+		_, err = stream.Recv()
+
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	srv.Stop(ctx)
+	assert.Less(t, time.Since(start), time.Second, "открытая сессия сканирования закрывается по таймауту")
+
+	_, err := stream.Recv()
+	assert.Error(t, err)
+}
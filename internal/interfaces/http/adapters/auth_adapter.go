@@ -2,11 +2,9 @@ package adapters
 
 import (
 	"context"
-	"errors"
 
 	appAuth "avito/internal/application/auth"
 	"avito/internal/domain/auth"
-	"avito/internal/interfaces/http/handlers"
 )
 
 type AuthServiceAdapter struct {
@@ -26,17 +24,7 @@ func (a *AuthServiceAdapter) Register(ctx context.Context, email, password strin
 		Role:     role,
 	}
 
-	user, err := a.service.Register(ctx, req)
-	if err != nil {
-		var userExistsErr *auth.ErrUserAlreadyExists
-		if errors.As(err, &userExistsErr) {
-			return nil, handlers.ErrEmailAlreadyExists
-		}
-
-		return nil, err
-	}
-
-	return user, nil
+	return a.service.Register(ctx, req)
 }
 
 func (a *AuthServiceAdapter) Login(ctx context.Context, email, password string) (string, error) {
@@ -47,11 +35,6 @@ func (a *AuthServiceAdapter) Login(ctx context.Context, email, password string)
 
 	authResult, err := a.service.Login(ctx, req)
 	if err != nil {
-		var invalidCredentialsErr *auth.ErrInvalidCredentials
-		if errors.As(err, &invalidCredentialsErr) {
-			return "", handlers.ErrInvalidCredentials
-		}
-
 		return "", err
 	}
 
@@ -5,6 +5,8 @@ import (
 
 	appPVZ "avito/internal/application/pvz"
 	"avito/internal/domain/pvz"
+
+	"github.com/google/uuid"
 )
 
 type PVZServiceAdapter struct {
@@ -21,6 +23,14 @@ func (a *PVZServiceAdapter) CreatePVZ(ctx context.Context, req pvz.CreatePVZRequ
 	return a.service.CreatePVZ(ctx, req)
 }
 
-func (a *PVZServiceAdapter) GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) ([]pvz.WithReceptions, error) {
+func (a *PVZServiceAdapter) GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) (*pvz.Page, error) {
 	return a.service.GetPVZs(ctx, req)
 }
+
+func (a *PVZServiceAdapter) GetPVZByID(ctx context.Context, id uuid.UUID) (*pvz.WithReceptions, error) {
+	return a.service.GetPVZWithReceptions(ctx, id)
+}
+
+func (a *PVZServiceAdapter) GetPVZsByIDs(ctx context.Context, ids []uuid.UUID) ([]pvz.WithReceptions, error) {
+	return a.service.GetPVZsByIDs(ctx, ids)
+}
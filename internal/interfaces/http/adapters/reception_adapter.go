@@ -2,11 +2,9 @@ package adapters
 
 import (
 	"context"
-	"errors"
 
 	appReception "avito/internal/application/reception"
 	"avito/internal/domain/reception"
-	"avito/internal/interfaces/http/handlers"
 
 	"github.com/google/uuid"
 )
@@ -26,29 +24,13 @@ func (a *ReceptionServiceAdapter) CreateReception(ctx context.Context, pvzID uui
 		PVZID: pvzID,
 	}
 
-	rec, err := a.service.CreateReception(ctx, req)
-	if err != nil {
-		var activeReceptionErr *reception.ErrActiveReceptionExists
-		if errors.As(err, &activeReceptionErr) {
-			return nil, handlers.ErrActiveReceptionExists
-		}
-
-		return nil, err
-	}
-
-	return rec, nil
+	return a.service.CreateReception(ctx, req)
 }
 
 func (a *ReceptionServiceAdapter) CloseLastReception(ctx context.Context, pvzID uuid.UUID) (*reception.Reception, error) {
-	rec, err := a.service.CloseReception(ctx, pvzID)
-	if err != nil {
-		var noActiveReceptionErr *reception.ErrNoActiveReception
-		if errors.As(err, &noActiveReceptionErr) {
-			return nil, handlers.ErrNoActiveReception
-		}
-
-		return nil, err
-	}
+	return a.service.CloseReception(ctx, pvzID)
+}
 
-	return rec, nil
+func (a *ReceptionServiceAdapter) ListReceptions(ctx context.Context, req reception.ListRequest) (*reception.Page, error) {
+	return a.service.ListReceptions(ctx, req)
 }
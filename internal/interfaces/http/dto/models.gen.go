@@ -39,35 +39,95 @@ const (
 	UserRoleModerator UserRole = "moderator"
 )
 
-// Defines values for PostDummyLoginJSONBodyRole.
+// Defines values for ViolationIn.
 const (
-	PostDummyLoginJSONBodyRoleEmployee  PostDummyLoginJSONBodyRole = "employee"
-	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
+	ViolationInBody   ViolationIn = "body"
+	ViolationInHeader ViolationIn = "header"
+	ViolationInPath   ViolationIn = "path"
+	ViolationInQuery  ViolationIn = "query"
 )
 
-// Defines values for PostProductsJSONBodyType.
+// Defines values for DummyLoginJSONBodyRole.
 const (
-	PostProductsJSONBodyTypeОбувь       PostProductsJSONBodyType = "обувь"
-	PostProductsJSONBodyTypeОдежда      PostProductsJSONBodyType = "одежда"
-	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
+	DummyLoginJSONBodyRoleEmployee  DummyLoginJSONBodyRole = "employee"
+	DummyLoginJSONBodyRoleModerator DummyLoginJSONBodyRole = "moderator"
 )
 
-// Defines values for GetPvzParamsCity.
+// Defines values for CreateProductJSONBodyType.
 const (
-	GetPvzParamsCityКазань         GetPvzParamsCity = "Казань"
-	GetPvzParamsCityМосква         GetPvzParamsCity = "Москва"
-	GetPvzParamsCityСанктПетербург GetPvzParamsCity = "Санкт-Петербург"
+	CreateProductJSONBodyTypeОбувь       CreateProductJSONBodyType = "обувь"
+	CreateProductJSONBodyTypeОдежда      CreateProductJSONBodyType = "одежда"
+	CreateProductJSONBodyTypeЭлектроника CreateProductJSONBodyType = "электроника"
 )
 
-// Defines values for PostRegisterJSONBodyRole.
+// Defines values for GetPVZsParamsCity.
 const (
-	Employee  PostRegisterJSONBodyRole = "employee"
-	Moderator PostRegisterJSONBodyRole = "moderator"
+	GetPVZsParamsCityКазань         GetPVZsParamsCity = "Казань"
+	GetPVZsParamsCityМосква         GetPVZsParamsCity = "Москва"
+	GetPVZsParamsCityСанктПетербург GetPVZsParamsCity = "Санкт-Петербург"
 )
 
-// Error defines model for Error.
+// Defines values for GetPVZsParamsReceptionStatus.
+const (
+	ReceptionStatusFilterClose      GetPVZsParamsReceptionStatus = "close"
+	ReceptionStatusFilterInProgress GetPVZsParamsReceptionStatus = "in_progress"
+)
+
+// Defines values for GetPVZsParamsProductType.
+const (
+	ProductTypeFilterClothes     GetPVZsParamsProductType = "одежда"
+	ProductTypeFilterElectronics GetPVZsParamsProductType = "электроника"
+	ProductTypeFilterShoes       GetPVZsParamsProductType = "обувь"
+)
+
+// Defines values for GetPVZsParamsSort.
+const (
+	SortLastActivity     GetPVZsParamsSort = "lastActivity"
+	SortReceptionCount   GetPVZsParamsSort = "receptionCount"
+	SortRegistrationDate GetPVZsParamsSort = "registrationDate"
+)
+
+// Defines values for GetPVZsParamsOrder.
+const (
+	OrderAsc  GetPVZsParamsOrder = "asc"
+	OrderDesc GetPVZsParamsOrder = "desc"
+)
+
+// Defines values for GetPVZsParamsInclude.
+const (
+	IncludeNone       GetPVZsParamsInclude = "none"
+	IncludeProducts   GetPVZsParamsInclude = "products"
+	IncludeReceptions GetPVZsParamsInclude = "receptions"
+)
+
+// Defines values for RegisterJSONBodyRole.
+const (
+	Employee  RegisterJSONBodyRole = "employee"
+	Moderator RegisterJSONBodyRole = "moderator"
+)
+
+// Error Ошибка в формате RFC 7807 (application/problem+json)
 type Error struct {
-	Message string `json:"message"`
+	// Code Стабильный машиночитаемый код ошибки, например PVZ_NOT_FOUND
+	Code string `json:"code"`
+
+	// Detail Описание конкретного случая ошибки
+	Detail string `json:"detail"`
+
+	// Errors Нарушения спецификации, найденные при проверке запроса
+	Errors *[]Violation `json:"errors,omitempty"`
+
+	// RequestId Идентификатор запроса, также передается в заголовке X-Request-ID
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Status HTTP-статус ответа
+	Status int `json:"status"`
+
+	// Title Краткое описание типа ошибки
+	Title string `json:"title"`
+
+	// Type URI типа ошибки, однозначно соответствует коду
+	Type string `json:"type"`
 }
 
 // PVZ defines model for PVZ.
@@ -80,6 +140,14 @@ type PVZ struct {
 // PVZCity defines model for PVZ.City.
 type PVZCity string
 
+// PVZWithReceptions defines model for PVZWithReceptions.
+type PVZWithReceptions struct {
+	// HasMoreReceptions Приемки обрезаны по receptionsLimit, полный список - GET /pvz/{pvzId}/receptions
+	HasMoreReceptions *bool                    `json:"hasMoreReceptions,omitempty"`
+	Pvz               *PVZ                     `json:"pvz,omitempty"`
+	Receptions        *[]ReceptionWithProducts `json:"receptions,omitempty"`
+}
+
 // Product defines model for Product.
 type Product struct {
 	DateTime    *time.Time          `binding:"required" json:"dateTime,omitempty"`
@@ -102,6 +170,14 @@ type Reception struct {
 // ReceptionStatus defines model for Reception.Status.
 type ReceptionStatus string
 
+// ReceptionWithProducts defines model for ReceptionWithProducts.
+type ReceptionWithProducts struct {
+	// HasMoreProducts Товары обрезаны по productsLimit, полный список - GET /receptions/{receptionId}/products
+	HasMoreProducts *bool      `json:"hasMoreProducts,omitempty"`
+	Products        *[]Product `json:"products,omitempty"`
+	Reception       *Reception `json:"reception,omitempty"`
+}
+
 // Token defines model for Token.
 type Token = string
 
@@ -115,79 +191,170 @@ type User struct {
 // UserRole defines model for User.Role.
 type UserRole string
 
-// PostDummyLoginJSONBody defines parameters for PostDummyLogin.
-type PostDummyLoginJSONBody struct {
-	Role PostDummyLoginJSONBodyRole `binding:"required" json:"role"`
+// Violation defines model for Violation.
+type Violation struct {
+	// In Часть запроса с ошибкой
+	In      ViolationIn `json:"in"`
+	Message string      `json:"message"`
+
+	// Name Имя параметра или путь к полю тела запроса (JSON Pointer)
+	Name *string `json:"name,omitempty"`
 }
 
-// PostDummyLoginJSONBodyRole defines parameters for PostDummyLogin.
-type PostDummyLoginJSONBodyRole string
+// ViolationIn Часть запроса с ошибкой
+type ViolationIn string
 
-// PostLoginJSONBody defines parameters for PostLogin.
-type PostLoginJSONBody struct {
+// DummyLoginJSONBody defines parameters for DummyLogin.
+type DummyLoginJSONBody struct {
+	Role DummyLoginJSONBodyRole `binding:"required" json:"role"`
+}
+
+// DummyLoginJSONBodyRole defines parameters for DummyLogin.
+type DummyLoginJSONBodyRole string
+
+// LoginJSONBody defines parameters for Login.
+type LoginJSONBody struct {
 	Email    openapi_types.Email `binding:"required" json:"email"`
 	Password string              `binding:"required" json:"password"`
 }
 
-// PostProductsJSONBody defines parameters for PostProducts.
-type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `binding:"required" json:"pvzId"`
-	Type  PostProductsJSONBodyType `binding:"required" json:"type"`
+// CreateProductJSONBody defines parameters for CreateProduct.
+type CreateProductJSONBody struct {
+	PvzId openapi_types.UUID        `binding:"required" json:"pvzId"`
+	Type  CreateProductJSONBodyType `binding:"required" json:"type"`
 }
 
-// PostProductsJSONBodyType defines parameters for PostProducts.
-type PostProductsJSONBodyType string
+// CreateProductJSONBodyType defines parameters for CreateProduct.
+type CreateProductJSONBodyType string
 
-// GetPvzParams defines parameters for GetPvz.
-type GetPvzParams struct {
-	// StartDate Начальная дата диапазона
+// GetPVZsParams defines parameters for GetPVZs.
+type GetPVZsParams struct {
+	// StartDate Начальная дата диапазона дат приемок
 	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-	// EndDate Конечная дата диапазона
+	// EndDate Конечная дата диапазона дат приемок
 	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
 
-	// City Фильтрация по городу
-	City *GetPvzParamsCity `form:"city,omitempty" json:"city,omitempty"`
+	// City Города через запятую
+	City *[]GetPVZsParamsCity `form:"city,omitempty" json:"city,omitempty"`
+
+	// ReceptionStatus Статусы приемок через запятую
+	ReceptionStatus *[]GetPVZsParamsReceptionStatus `form:"receptionStatus,omitempty" json:"receptionStatus,omitempty"`
+
+	// ProductType Типы товаров через запятую, остаются приемки хотя бы с одним товаром этих типов
+	ProductType *[]GetPVZsParamsProductType `form:"productType,omitempty" json:"productType,omitempty"`
+
+	// HasOpenReception Только ПВЗ с незакрытой приемкой (true) или без нее (false)
+	HasOpenReception *bool `form:"hasOpenReception,omitempty" json:"hasOpenReception,omitempty"`
+
+	// RegisteredFrom Начальная дата диапазона дат регистрации ПВЗ
+	RegisteredFrom *time.Time `form:"registeredFrom,omitempty" json:"registeredFrom,omitempty"`
+
+	// RegisteredTo Конечная дата диапазона дат регистрации ПВЗ
+	RegisteredTo *time.Time `form:"registeredTo,omitempty" json:"registeredTo,omitempty"`
+
+	// Sort Поле сортировки: дата регистрации, количество всех приемок ПВЗ или время последней активности
+	// (регистрации, создания приемки или добавления товара)
+	Sort *GetPVZsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-	// Page Номер страницы
+	// Order Направление сортировки. Курсор действителен только для той сортировки, с которой получен
+	Order *GetPVZsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Page Номер страницы. Устаревший способ пагинации, вместо него лучше использовать cursor
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
 	// Limit Количество элементов на странице
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор страницы из заголовка X-Next-Cursor предыдущего ответа. Несовместим с page > 1.
+	// Фильтры и limit нужно передавать те же, что и для предыдущей страницы.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// WithTotal Вернуть общее количество ПВЗ под фильтрами в заголовке X-Total-Count
+	WithTotal *bool `form:"withTotal,omitempty" json:"withTotal,omitempty"`
+
+	// Include Вложенные данные через запятую. Без параметра вкладываются приемки и товары,
+	// none - только ПВЗ без вложенных данных; products без receptions не допускается
+	Include *[]GetPVZsParamsInclude `form:"include,omitempty" json:"include,omitempty"`
+
+	// ReceptionsLimit Максимум последних приемок на ПВЗ, без параметра - все
+	ReceptionsLimit *int `form:"receptionsLimit,omitempty" json:"receptionsLimit,omitempty"`
+
+	// ProductsLimit Максимум первых товаров на приемку, без параметра - все
+	ProductsLimit *int `form:"productsLimit,omitempty" json:"productsLimit,omitempty"`
 }
 
-// GetPvzParamsCity defines parameters for GetPvz.
-type GetPvzParamsCity string
+// GetPVZsParamsCity defines parameters for GetPVZs.
+type GetPVZsParamsCity string
+
+// GetPVZsParamsReceptionStatus defines parameters for GetPVZs.
+type GetPVZsParamsReceptionStatus string
 
-// PostReceptionsJSONBody defines parameters for PostReceptions.
-type PostReceptionsJSONBody struct {
+// GetPVZsParamsProductType defines parameters for GetPVZs.
+type GetPVZsParamsProductType string
+
+// GetPVZsParamsSort defines parameters for GetPVZs.
+type GetPVZsParamsSort string
+
+// GetPVZsParamsOrder defines parameters for GetPVZs.
+type GetPVZsParamsOrder string
+
+// GetPVZsParamsInclude defines parameters for GetPVZs.
+type GetPVZsParamsInclude string
+
+// GetPVZsByIDsParams defines parameters for GetPVZsByIDs.
+type GetPVZsByIDsParams struct {
+	// Ids ID ПВЗ через запятую, не более 30 различных; повторяющиеся ID учитываются один раз
+	Ids []openapi_types.UUID `form:"ids" json:"ids"`
+}
+
+// GetPVZReceptionsParams defines parameters for GetPVZReceptions.
+type GetPVZReceptionsParams struct {
+	// Limit Количество приемок на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор страницы из заголовка X-Next-Cursor предыдущего ответа
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// CreateReceptionJSONBody defines parameters for CreateReception.
+type CreateReceptionJSONBody struct {
 	PvzId openapi_types.UUID `binding:"required" json:"pvzId"`
 }
 
-// PostRegisterJSONBody defines parameters for PostRegister.
-type PostRegisterJSONBody struct {
-	Email    openapi_types.Email      `binding:"required" json:"email"`
-	Password string                   `binding:"required" json:"password"`
-	Role     PostRegisterJSONBodyRole `binding:"required" json:"role"`
+// GetReceptionProductsParams defines parameters for GetReceptionProducts.
+type GetReceptionProductsParams struct {
+	// Limit Количество товаров на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор страницы из заголовка X-Next-Cursor предыдущего ответа
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// RegisterJSONBody defines parameters for Register.
+type RegisterJSONBody struct {
+	Email    openapi_types.Email  `binding:"required" json:"email"`
+	Password string               `binding:"required" json:"password"`
+	Role     RegisterJSONBodyRole `binding:"required" json:"role"`
 }
 
-// PostRegisterJSONBodyRole defines parameters for PostRegister.
-type PostRegisterJSONBodyRole string
+// RegisterJSONBodyRole defines parameters for Register.
+type RegisterJSONBodyRole string
 
-// PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
-type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
+// DummyLoginJSONRequestBody defines body for DummyLogin for application/json ContentType.
+type DummyLoginJSONRequestBody DummyLoginJSONBody
 
-// PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
-type PostLoginJSONRequestBody PostLoginJSONBody
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody LoginJSONBody
 
-// PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
-type PostProductsJSONRequestBody PostProductsJSONBody
+// CreateProductJSONRequestBody defines body for CreateProduct for application/json ContentType.
+type CreateProductJSONRequestBody CreateProductJSONBody
 
-// PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
-type PostPvzJSONRequestBody = PVZ
+// CreatePVZJSONRequestBody defines body for CreatePVZ for application/json ContentType.
+type CreatePVZJSONRequestBody = PVZ
 
-// PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
-type PostReceptionsJSONRequestBody PostReceptionsJSONBody
+// CreateReceptionJSONRequestBody defines body for CreateReception for application/json ContentType.
+type CreateReceptionJSONRequestBody CreateReceptionJSONBody
 
-// PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
-type PostRegisterJSONRequestBody PostRegisterJSONBody
+// RegisterJSONRequestBody defines body for Register for application/json ContentType.
+type RegisterJSONRequestBody RegisterJSONBody
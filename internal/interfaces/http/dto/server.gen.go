@@ -0,0 +1,1623 @@
+//go:build go1.22
+
+// Package dto provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package dto
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Получение тестового токена
+	// (POST /dummyLogin)
+	DummyLogin(w http.ResponseWriter, r *http.Request)
+	// Авторизация пользователя
+	// (POST /login)
+	Login(w http.ResponseWriter, r *http.Request)
+	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products)
+	CreateProduct(w http.ResponseWriter, r *http.Request)
+	// Получение списка ПВЗ с фильтрацией, сортировкой и пагинацией
+	// (GET /pvz)
+	GetPVZs(w http.ResponseWriter, r *http.Request, params GetPVZsParams)
+	// Создание ПВЗ (только для модераторов)
+	// (POST /pvz)
+	CreatePVZ(w http.ResponseWriter, r *http.Request)
+	// Получение нескольких ПВЗ по списку ID
+	// (GET /pvz/batch)
+	GetPVZsByIDs(w http.ResponseWriter, r *http.Request, params GetPVZsByIDsParams)
+	// Получение ПВЗ по ID вместе с приемками и товарами
+	// (GET /pvz/{pvzId})
+	GetPVZByID(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Закрытие последней открытой приемки товаров в рамках ПВЗ
+	// (POST /pvz/{pvzId}/close_last_reception)
+	CloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/delete_last_product)
+	DeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Получение приемок ПВЗ постранично, от новых к старым
+	// (GET /pvz/{pvzId}/receptions)
+	GetPVZReceptions(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, params GetPVZReceptionsParams)
+	// Создание новой приемки товаров (только для сотрудников ПВЗ)
+	// (POST /receptions)
+	CreateReception(w http.ResponseWriter, r *http.Request)
+	// Получение товаров приемки постранично, в порядке добавления
+	// (GET /receptions/{receptionId}/products)
+	GetReceptionProducts(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID, params GetReceptionProductsParams)
+	// Регистрация пользователя
+	// (POST /register)
+	Register(w http.ResponseWriter, r *http.Request)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// DummyLogin operation middleware
+func (siw *ServerInterfaceWrapper) DummyLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DummyLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProduct operation middleware
+func (siw *ServerInterfaceWrapper) CreateProduct(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProduct(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPVZs operation middleware
+func (siw *ServerInterfaceWrapper) GetPVZs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPVZsParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "city", r.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "city", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "receptionStatus" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "receptionStatus", r.URL.Query(), &params.ReceptionStatus)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionStatus", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "productType" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "productType", r.URL.Query(), &params.ProductType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "hasOpenReception" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hasOpenReception", r.URL.Query(), &params.HasOpenReception)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hasOpenReception", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "registeredFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "registeredFrom", r.URL.Query(), &params.RegisteredFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registeredFrom", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "registeredTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "registeredTo", r.URL.Query(), &params.RegisteredTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registeredTo", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "withTotal" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "withTotal", r.URL.Query(), &params.WithTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "withTotal", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "receptionsLimit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "receptionsLimit", r.URL.Query(), &params.ReceptionsLimit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionsLimit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "productsLimit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "productsLimit", r.URL.Query(), &params.ProductsLimit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productsLimit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPVZs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePVZ operation middleware
+func (siw *ServerInterfaceWrapper) CreatePVZ(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePVZ(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPVZsByIDs operation middleware
+func (siw *ServerInterfaceWrapper) GetPVZsByIDs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPVZsByIDsParams
+
+	// ------------- Required query parameter "ids" -------------
+
+	if paramValue := r.URL.Query().Get("ids"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ids"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", false, true, "ids", r.URL.Query(), &params.Ids)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ids", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPVZsByIDs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPVZByID operation middleware
+func (siw *ServerInterfaceWrapper) GetPVZByID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPVZByID(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloseLastReception operation middleware
+func (siw *ServerInterfaceWrapper) CloseLastReception(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloseLastReception(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteLastProduct operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLastProduct(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLastProduct(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPVZReceptions operation middleware
+func (siw *ServerInterfaceWrapper) GetPVZReceptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", r.PathValue("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPVZReceptionsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPVZReceptions(w, r, pvzId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateReception operation middleware
+func (siw *ServerInterfaceWrapper) CreateReception(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateReception(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptionProducts operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionProducts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", r.PathValue("receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReceptionProductsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReceptionProducts(w, r, receptionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Register operation middleware
+func (siw *ServerInterfaceWrapper) Register(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Register(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("POST "+options.BaseURL+"/dummyLogin", wrapper.DummyLogin)
+	m.HandleFunc("POST "+options.BaseURL+"/login", wrapper.Login)
+	m.HandleFunc("POST "+options.BaseURL+"/products", wrapper.CreateProduct)
+	m.HandleFunc("GET "+options.BaseURL+"/pvz", wrapper.GetPVZs)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz", wrapper.CreatePVZ)
+	m.HandleFunc("GET "+options.BaseURL+"/pvz/batch", wrapper.GetPVZsByIDs)
+	m.HandleFunc("GET "+options.BaseURL+"/pvz/{pvzId}", wrapper.GetPVZByID)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/close_last_reception", wrapper.CloseLastReception)
+	m.HandleFunc("POST "+options.BaseURL+"/pvz/{pvzId}/delete_last_product", wrapper.DeleteLastProduct)
+	m.HandleFunc("GET "+options.BaseURL+"/pvz/{pvzId}/receptions", wrapper.GetPVZReceptions)
+	m.HandleFunc("POST "+options.BaseURL+"/receptions", wrapper.CreateReception)
+	m.HandleFunc("GET "+options.BaseURL+"/receptions/{receptionId}/products", wrapper.GetReceptionProducts)
+	m.HandleFunc("POST "+options.BaseURL+"/register", wrapper.Register)
+
+	return m
+}
+
+type DummyLoginRequestObject struct {
+	Body *DummyLoginJSONRequestBody
+}
+
+type DummyLoginResponseObject interface {
+	VisitDummyLoginResponse(w http.ResponseWriter) error
+}
+
+type DummyLogin200JSONResponse Token
+
+func (response DummyLogin200JSONResponse) VisitDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DummyLogin400ApplicationProblemPlusJSONResponse Error
+
+func (response DummyLogin400ApplicationProblemPlusJSONResponse) VisitDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200JSONResponse Token
+
+func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login401ApplicationProblemPlusJSONResponse Error
+
+func (response Login401ApplicationProblemPlusJSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProductRequestObject struct {
+	Body *CreateProductJSONRequestBody
+}
+
+type CreateProductResponseObject interface {
+	VisitCreateProductResponse(w http.ResponseWriter) error
+}
+
+type CreateProduct201JSONResponse Product
+
+func (response CreateProduct201JSONResponse) VisitCreateProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProduct400ApplicationProblemPlusJSONResponse Error
+
+func (response CreateProduct400ApplicationProblemPlusJSONResponse) VisitCreateProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProduct403ApplicationProblemPlusJSONResponse Error
+
+func (response CreateProduct403ApplicationProblemPlusJSONResponse) VisitCreateProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProduct404ApplicationProblemPlusJSONResponse Error
+
+func (response CreateProduct404ApplicationProblemPlusJSONResponse) VisitCreateProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPVZsRequestObject struct {
+	Params GetPVZsParams
+}
+
+type GetPVZsResponseObject interface {
+	VisitGetPVZsResponse(w http.ResponseWriter) error
+}
+
+type GetPVZs200ResponseHeaders struct {
+	Link        string
+	XNextCursor string
+	XTotalCount int
+}
+
+type GetPVZs200JSONResponse struct {
+	Body    []PVZWithReceptions
+	Headers GetPVZs200ResponseHeaders
+}
+
+func (response GetPVZs200JSONResponse) VisitGetPVZsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPVZs400ApplicationProblemPlusJSONResponse Error
+
+func (response GetPVZs400ApplicationProblemPlusJSONResponse) VisitGetPVZsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePVZRequestObject struct {
+	Body *CreatePVZJSONRequestBody
+}
+
+type CreatePVZResponseObject interface {
+	VisitCreatePVZResponse(w http.ResponseWriter) error
+}
+
+type CreatePVZ201JSONResponse PVZ
+
+func (response CreatePVZ201JSONResponse) VisitCreatePVZResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePVZ400ApplicationProblemPlusJSONResponse Error
+
+func (response CreatePVZ400ApplicationProblemPlusJSONResponse) VisitCreatePVZResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePVZ403ApplicationProblemPlusJSONResponse Error
+
+func (response CreatePVZ403ApplicationProblemPlusJSONResponse) VisitCreatePVZResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPVZsByIDsRequestObject struct {
+	Params GetPVZsByIDsParams
+}
+
+type GetPVZsByIDsResponseObject interface {
+	VisitGetPVZsByIDsResponse(w http.ResponseWriter) error
+}
+
+type GetPVZsByIDs200JSONResponse []PVZWithReceptions
+
+func (response GetPVZsByIDs200JSONResponse) VisitGetPVZsByIDsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPVZsByIDs400ApplicationProblemPlusJSONResponse Error
+
+func (response GetPVZsByIDs400ApplicationProblemPlusJSONResponse) VisitGetPVZsByIDsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPVZByIDRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type GetPVZByIDResponseObject interface {
+	VisitGetPVZByIDResponse(w http.ResponseWriter) error
+}
+
+type GetPVZByID200JSONResponse PVZWithReceptions
+
+func (response GetPVZByID200JSONResponse) VisitGetPVZByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPVZByID400ApplicationProblemPlusJSONResponse Error
+
+func (response GetPVZByID400ApplicationProblemPlusJSONResponse) VisitGetPVZByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPVZByID404ApplicationProblemPlusJSONResponse Error
+
+func (response GetPVZByID404ApplicationProblemPlusJSONResponse) VisitGetPVZByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloseLastReceptionRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type CloseLastReceptionResponseObject interface {
+	VisitCloseLastReceptionResponse(w http.ResponseWriter) error
+}
+
+type CloseLastReception200JSONResponse Reception
+
+func (response CloseLastReception200JSONResponse) VisitCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloseLastReception400ApplicationProblemPlusJSONResponse Error
+
+func (response CloseLastReception400ApplicationProblemPlusJSONResponse) VisitCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloseLastReception403ApplicationProblemPlusJSONResponse Error
+
+func (response CloseLastReception403ApplicationProblemPlusJSONResponse) VisitCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloseLastReception404ApplicationProblemPlusJSONResponse Error
+
+func (response CloseLastReception404ApplicationProblemPlusJSONResponse) VisitCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLastProductRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type DeleteLastProductResponseObject interface {
+	VisitDeleteLastProductResponse(w http.ResponseWriter) error
+}
+
+type DeleteLastProduct200Response struct {
+}
+
+func (response DeleteLastProduct200Response) VisitDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteLastProduct400ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteLastProduct400ApplicationProblemPlusJSONResponse) VisitDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLastProduct403ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteLastProduct403ApplicationProblemPlusJSONResponse) VisitDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLastProduct404ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteLastProduct404ApplicationProblemPlusJSONResponse) VisitDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPVZReceptionsRequestObject struct {
+	PvzId  openapi_types.UUID `json:"pvzId"`
+	Params GetPVZReceptionsParams
+}
+
+type GetPVZReceptionsResponseObject interface {
+	VisitGetPVZReceptionsResponse(w http.ResponseWriter) error
+}
+
+type GetPVZReceptions200ResponseHeaders struct {
+	Link        string
+	XNextCursor string
+}
+
+type GetPVZReceptions200JSONResponse struct {
+	Body    []Reception
+	Headers GetPVZReceptions200ResponseHeaders
+}
+
+func (response GetPVZReceptions200JSONResponse) VisitGetPVZReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPVZReceptions400ApplicationProblemPlusJSONResponse Error
+
+func (response GetPVZReceptions400ApplicationProblemPlusJSONResponse) VisitGetPVZReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPVZReceptions404ApplicationProblemPlusJSONResponse Error
+
+func (response GetPVZReceptions404ApplicationProblemPlusJSONResponse) VisitGetPVZReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReceptionRequestObject struct {
+	Body *CreateReceptionJSONRequestBody
+}
+
+type CreateReceptionResponseObject interface {
+	VisitCreateReceptionResponse(w http.ResponseWriter) error
+}
+
+type CreateReception201JSONResponse Reception
+
+func (response CreateReception201JSONResponse) VisitCreateReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReception400ApplicationProblemPlusJSONResponse Error
+
+func (response CreateReception400ApplicationProblemPlusJSONResponse) VisitCreateReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReception403ApplicationProblemPlusJSONResponse Error
+
+func (response CreateReception403ApplicationProblemPlusJSONResponse) VisitCreateReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReception404ApplicationProblemPlusJSONResponse Error
+
+func (response CreateReception404ApplicationProblemPlusJSONResponse) VisitCreateReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionProductsRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Params      GetReceptionProductsParams
+}
+
+type GetReceptionProductsResponseObject interface {
+	VisitGetReceptionProductsResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionProducts200ResponseHeaders struct {
+	Link        string
+	XNextCursor string
+}
+
+type GetReceptionProducts200JSONResponse struct {
+	Body    []Product
+	Headers GetReceptionProducts200ResponseHeaders
+}
+
+func (response GetReceptionProducts200JSONResponse) VisitGetReceptionProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetReceptionProducts400ApplicationProblemPlusJSONResponse Error
+
+func (response GetReceptionProducts400ApplicationProblemPlusJSONResponse) VisitGetReceptionProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionProducts404ApplicationProblemPlusJSONResponse Error
+
+func (response GetReceptionProducts404ApplicationProblemPlusJSONResponse) VisitGetReceptionProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterRequestObject struct {
+	Body *RegisterJSONRequestBody
+}
+
+type RegisterResponseObject interface {
+	VisitRegisterResponse(w http.ResponseWriter) error
+}
+
+type Register201JSONResponse User
+
+func (response Register201JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Register400ApplicationProblemPlusJSONResponse Error
+
+func (response Register400ApplicationProblemPlusJSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Получение тестового токена
+	// (POST /dummyLogin)
+	DummyLogin(ctx context.Context, request DummyLoginRequestObject) (DummyLoginResponseObject, error)
+	// Авторизация пользователя
+	// (POST /login)
+	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products)
+	CreateProduct(ctx context.Context, request CreateProductRequestObject) (CreateProductResponseObject, error)
+	// Получение списка ПВЗ с фильтрацией, сортировкой и пагинацией
+	// (GET /pvz)
+	GetPVZs(ctx context.Context, request GetPVZsRequestObject) (GetPVZsResponseObject, error)
+	// Создание ПВЗ (только для модераторов)
+	// (POST /pvz)
+	CreatePVZ(ctx context.Context, request CreatePVZRequestObject) (CreatePVZResponseObject, error)
+	// Получение нескольких ПВЗ по списку ID
+	// (GET /pvz/batch)
+	GetPVZsByIDs(ctx context.Context, request GetPVZsByIDsRequestObject) (GetPVZsByIDsResponseObject, error)
+	// Получение ПВЗ по ID вместе с приемками и товарами
+	// (GET /pvz/{pvzId})
+	GetPVZByID(ctx context.Context, request GetPVZByIDRequestObject) (GetPVZByIDResponseObject, error)
+	// Закрытие последней открытой приемки товаров в рамках ПВЗ
+	// (POST /pvz/{pvzId}/close_last_reception)
+	CloseLastReception(ctx context.Context, request CloseLastReceptionRequestObject) (CloseLastReceptionResponseObject, error)
+	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/delete_last_product)
+	DeleteLastProduct(ctx context.Context, request DeleteLastProductRequestObject) (DeleteLastProductResponseObject, error)
+	// Получение приемок ПВЗ постранично, от новых к старым
+	// (GET /pvz/{pvzId}/receptions)
+	GetPVZReceptions(ctx context.Context, request GetPVZReceptionsRequestObject) (GetPVZReceptionsResponseObject, error)
+	// Создание новой приемки товаров (только для сотрудников ПВЗ)
+	// (POST /receptions)
+	CreateReception(ctx context.Context, request CreateReceptionRequestObject) (CreateReceptionResponseObject, error)
+	// Получение товаров приемки постранично, в порядке добавления
+	// (GET /receptions/{receptionId}/products)
+	GetReceptionProducts(ctx context.Context, request GetReceptionProductsRequestObject) (GetReceptionProductsResponseObject, error)
+	// Регистрация пользователя
+	// (POST /register)
+	Register(ctx context.Context, request RegisterRequestObject) (RegisterResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// DummyLogin operation middleware
+func (sh *strictHandler) DummyLogin(w http.ResponseWriter, r *http.Request) {
+	var request DummyLoginRequestObject
+
+	var body DummyLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DummyLogin(ctx, request.(DummyLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DummyLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DummyLoginResponseObject); ok {
+		if err := validResponse.VisitDummyLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Login operation middleware
+func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var request LoginRequestObject
+
+	var body LoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateProduct operation middleware
+func (sh *strictHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
+	var request CreateProductRequestObject
+
+	var body CreateProductJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateProduct(ctx, request.(CreateProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateProduct")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateProductResponseObject); ok {
+		if err := validResponse.VisitCreateProductResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPVZs operation middleware
+func (sh *strictHandler) GetPVZs(w http.ResponseWriter, r *http.Request, params GetPVZsParams) {
+	var request GetPVZsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPVZs(ctx, request.(GetPVZsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPVZs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPVZsResponseObject); ok {
+		if err := validResponse.VisitGetPVZsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePVZ operation middleware
+func (sh *strictHandler) CreatePVZ(w http.ResponseWriter, r *http.Request) {
+	var request CreatePVZRequestObject
+
+	var body CreatePVZJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePVZ(ctx, request.(CreatePVZRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePVZ")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePVZResponseObject); ok {
+		if err := validResponse.VisitCreatePVZResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPVZsByIDs operation middleware
+func (sh *strictHandler) GetPVZsByIDs(w http.ResponseWriter, r *http.Request, params GetPVZsByIDsParams) {
+	var request GetPVZsByIDsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPVZsByIDs(ctx, request.(GetPVZsByIDsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPVZsByIDs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPVZsByIDsResponseObject); ok {
+		if err := validResponse.VisitGetPVZsByIDsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPVZByID operation middleware
+func (sh *strictHandler) GetPVZByID(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request GetPVZByIDRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPVZByID(ctx, request.(GetPVZByIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPVZByID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPVZByIDResponseObject); ok {
+		if err := validResponse.VisitGetPVZByIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CloseLastReception operation middleware
+func (sh *strictHandler) CloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request CloseLastReceptionRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CloseLastReception(ctx, request.(CloseLastReceptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CloseLastReception")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CloseLastReceptionResponseObject); ok {
+		if err := validResponse.VisitCloseLastReceptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteLastProduct operation middleware
+func (sh *strictHandler) DeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request DeleteLastProductRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteLastProduct(ctx, request.(DeleteLastProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteLastProduct")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteLastProductResponseObject); ok {
+		if err := validResponse.VisitDeleteLastProductResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPVZReceptions operation middleware
+func (sh *strictHandler) GetPVZReceptions(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, params GetPVZReceptionsParams) {
+	var request GetPVZReceptionsRequestObject
+
+	request.PvzId = pvzId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPVZReceptions(ctx, request.(GetPVZReceptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPVZReceptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPVZReceptionsResponseObject); ok {
+		if err := validResponse.VisitGetPVZReceptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateReception operation middleware
+func (sh *strictHandler) CreateReception(w http.ResponseWriter, r *http.Request) {
+	var request CreateReceptionRequestObject
+
+	var body CreateReceptionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateReception(ctx, request.(CreateReceptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateReception")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateReceptionResponseObject); ok {
+		if err := validResponse.VisitCreateReceptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReceptionProducts operation middleware
+func (sh *strictHandler) GetReceptionProducts(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID, params GetReceptionProductsParams) {
+	var request GetReceptionProductsRequestObject
+
+	request.ReceptionId = receptionId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionProducts(ctx, request.(GetReceptionProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionProducts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReceptionProductsResponseObject); ok {
+		if err := validResponse.VisitGetReceptionProductsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Register operation middleware
+func (sh *strictHandler) Register(w http.ResponseWriter, r *http.Request) {
+	var request RegisterRequestObject
+
+	var body RegisterJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Register(ctx, request.(RegisterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Register")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterResponseObject); ok {
+		if err := validResponse.VisitRegisterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
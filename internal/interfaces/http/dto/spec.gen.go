@@ -0,0 +1,160 @@
+// Package dto provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package dto
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xcb28bx5n/Kou5eyHjlpZct2igQ1+kVtyq59o6W3ELR4axIsfSNuQuu7t0rAQEJDKu",
+	"k5Mb3eUKNMi1zaU54N5StDaiKJH6Cs98o8PzzOz/IUVZlKz4/ErUcnfm+T+/58/yE1Z2a3XX4U7gs/lP",
+	"mF9e5zWLPr7nea6HHyrcL3t2PbBdh80z+Jv4DHqwC33oGNA1xKcwFJtwBB3RgtC4e/OG8dN35n5qzFj1",
+	"etUuW/jYbN1zV6u89k+/813nCjNZ3XPr3AtsTjuV3QrXbPStaEEHdqEHh+IFDMQ2HBi0D+4/gKF4Dj26",
+	"JYQj+WUfhrBnwDCmsGcaMIAOHItN6MERhGLTWLr/4NHtO8uPbt55//YCM1mwUedsnvmBZztrrGmyCg8s",
+	"u6rjHI6hJ7agAwPoQSj3G0BfbEIoWkgSvIShIbbgULTFc+iInQwxus04StnXbPZX6IhN0RafQYjbiR1c",
+	"9xhC8QfoiU+hh/LHzzGPB7BHt6KgQkOyLP8MoYucQx+v7ytxDJERZjI74DXa/x89/pjNs3+YTSxiVpnD",
+	"7H3brZImWTPmwfI8awP/9/jvG9wPFisaLr6SRIlWiugWGkyOENMgTfbhe6KdyA1hD5UrWmILBdmVj7yE",
+	"IRwSS8jOb0t35e6lRa0u/cAKGhrx/nJ5eakktnBT0RJtsYWKapGcWtBJVrKdgK9xj9i2g6rOTL8Wm8QU",
+	"GkNowDBnJMg6HEPnREuQF/LLv393Ub+EiVvtkdHto/7Fc/yMRjJMWCEOu6KNn5V7iHZxc6VE2+MVNv+B",
+	"/DZiOJZh7BimdNiH8TLu6u94OUAelu4/QBZy7m0HG/iXO40aLg9/IZ33oUuShm9JVn3RKsE3JH9U/q5o",
+	"i014id9/DR3S/EC8YA/ztJvsacm16nYJaVrjTok/DTyrFFhrtPeq7VTwtvmEwWbhkaclJK30xPIcq4Y0",
+	"f8B+7fpl9yNmsnuW7QRLPOCev9rwcL9/sT62HPawaTKbLP6x69WsgM2zRsOusCnQR9pYs/3AI5dbsAKe",
+	"2adiBbwU2DU+lc1yuidljVDtb+xg/S4vc7JNv6jodcv/tevx7C05b/mGInEIR2jDaMK75OlSv9vo+kPD",
+	"ixe4ZdfswKSrcKiOALGlPGwIfaNk/OK9ZWO2/uTj2U/qTz5erDRnk6cT+ay6bpVbFL3qTz4+KdqhFZNY",
+	"0nxMFCdj1lFUS55baZQDvxgzmzr5yruLUkV1L9u187SB8zVlJZLFc9siipxRgBF/hEMIMaLQ4TeQ5w4z",
+	"GUXMEL6HvejfXdGG7gXGlfeqvBx4rmOX0TpvVN1gneOne+su99nDEZE4LUOdb8Zm98ZZD/n0eS2egIPI",
+	"cmznUd1z1zzuo1LKVdfnUzGNvF5jrUQcxsQU1Dt2t2iz+ZV4+RWWsYhMIBoVsdM35OL13wlqIRTdHhGt",
+	"6+rhSWN1ElZnP0kZdnM2WkgftlMkThSKFU96wJpyl4nCuT5kL7sfclqjgOXe97lXlDavqbwiNmZ55dKH",
+	"cLeaia+8Vq+6Gxytt+ZWuGcFrncubhKJhwjQBb4kLykI23Y01vy/0EFILF7k0g9DbKXB9RAOmBmzW7eC",
+	"dWay3ze4t8FMts6tCveYyVbdyoae7ULYj+lcdJbkaqkr/6oWTl36ZbRH6trPabumyWrc9601rjU83FGb",
+	"hh1hCnWMjgwdSoTxbOwYmFzLNLEtxdJXDiy+wLQjhEPo5GU186t7d24bSy7mRt6VE7MJ22EJzUUlYhzm",
+	"5YZnBxv30O2k9la55XHv3Uawnvx3MzLtX/1mGQMm3c3m1bcJHetBUJe2ZDuPXV1hgTKMLgYmA/bgEHPr",
+	"NjHYgS4cxgk3fANfwp+NKI2OYOsQA1sriov4N06W5tmqVf6QOxXD594Tu4wu8oR7vtz42tW5q3OoJbfO",
+	"Hatus3l2nS6ZZGPE+GylUatt3HLXpP3WXZ8gIVq2FaEotpDcE6ffZB5UTHEC7tBD6QoMVl6SAk/RXV6b",
+	"j4/w7extgdfgdMGvu44vKf7R3Nyp+B0X6GUkp01ztvKdqrp8Rjn2joEmIisY0IN9WYIRO6jUH4+lJ10B",
+	"m5wuWYPT0fVXCFVVRxXGUj4qnapRq1neBuVcMFQVqTApSoRiSxmxqlrhP326o0MLzFbHG+F07e98z8a6",
+	"5fsfuV6lGDOneErFm7wZ1nzttVlzaEhjFS31LxUCVWUzb9z/ruMgOsRewL6K03SWiR1p2WkwqTfuGx63",
+	"gggXT83IzzWbeeOzYCm9V3Oua1Nzrjiv0JhxnCyhxQ5hN8ETl+uAiGHfQBaGO2gl0IMulZMPsnCnJ2m/",
+	"foG0/wmJxLo8HCd0h+LzRJI/vkBqFAocQJhptGSgK5v/IAtaP3jYfJiJU3/KGkR0DEcoUvbSWuSwbfG5",
+	"aIsvMmoQbWNGtFRQ68MwBq5bWO3HThHsKR8fYquEiL6iwp0sea7xQAOG/0f210QrSfH/jVovPRiIHfFF",
+	"1H7BVB++Mo242xCj5KgNQSji02Q5TKueq07OfqTHHWq3fGGUohXhv+CrqytOno6EdSwdzPiB5QVYCTcN",
+	"7lTkhziNv0elEzOqRCxv1PkVA7piG5uHSAdykTPqeZJ3ujyB9GCGBHvRAyTDFUc8IxHvGLArtmWmqDg+",
+	"ULuITXk+FZ1nCAcmJRDdVIPJoObVIW4ltkn/kZSzGs6snerqRTxcXXGYmTu4fsGDpfsPfIIjnlWjxgUZ",
+	"p6a9+Bw6srUqscAeHZMdg3TfIVHs08HRUd/ltMJMSrLjxFjmnixWFTNTrjdB7bHZNIvNNSIgFM+nTKQy",
+	"ommQ+J8EPtAkxtg7Mxl/Wq9So/uxVfW5nizqvqRpiktd59I809TG/GCD8lgUBmua+qa8bJkW3fSs7Oc8",
+	"erwkJq7VFuDH3ew2N+1qwL1FZylZTXvHDbnFKwjt79i/FdvSveOqwWhxmYY8AFORIev5xqiY1IOj7C5H",
+	"hvgjdd+fRW1kWa+YRB2peDpeFdOCmgVNLSUUSB1kkWPh6wRKFr6KsOWraC8VkyUYQIEPVCG8jweWaGlj",
+	"vzGDcPRKjLd2pbLx0dCYIdFfGRGh1i3/Tp07sSVmNJCvjzfN6QR4ssaXeCTKA1wOmCiu2SinxV4193jl",
+	"pufWXlPQPxvhy+40yKYyC4QSkm2S022qQZXevCIUOiNINeV4xiH0KCrQ3IbEAFsQimf5SBuXJqVddWlR",
+	"VePF8+GQIBza2UEO3tPSvRVnZhQdSD7sq5S7Vww90Z57eUArdtKhp3NlxRkheN/1gozAK/yx1agGsU5S",
+	"gw9JGV7zVXxe3HAbDi5Ztfzg3XJgP8lOMYwLMfdcL7hbXFpezi2PF29lthjhefli8giruGrA13hUy+8M",
+	"ipUHSvc9VX4PYWBogb+MOLplTYpPfQKcEpscRAURVQQcoRjXk10HnWaQyZQ2LPqPLk4m5zu49rv0FH1c",
+	"oEf18huqUb3INMm6/iC2rxpYwaJzEY23Sw0b1WUckt3uUpMDzRoGiUlDlxbEJ4cy+mKuIsWBA3YGJQGF",
+	"kpF4YZQbnu96I8RVx46GVlrXTFazHbuGsrpWHCYbEfPyzq+O1SM1QUd53QA6ObFAOIK8KjZkR9A3Z7Ka",
+	"9VQSeH3u9NSmrDavJAwQ+8VZvY7x29Jt/jQo3SCRyqgSUga0hxlvpJTUGN5VA6sYpNdEgYRwtgyUvbHS",
+	"mJu7zo1rmvyxZxD7BgxEG76n0bjMWGGkYBpaxalD0xDPpX30IhfTkHhQtMmRYS62ncK5PeYQ+VKVbFQ7",
+	"DgHT54QW9MeDOgaOafA1m3zDkco7dVOTy25gVUtRXNNR/5EdrNNdegtSsHECIPIlbfx9ejY1Vc4dDYKv",
+	"GvAf8rKmbTkqgS6cVakjSWybK47jOtwo5SKqkqJCZ90sweJZimDx7J/jgYfogWSgQRWJ8GTEhiqmafEA",
+	"64ozIeq2nXK1UTkBcWeG3FKDE8jehAF5Ue5zN72SuraULKiu3KZ1XwE8/4XQxxa6rWjDUR6f9DTQhmAd",
+	"qcSMdVI0gZLCRuyEVFLOpWSkGUe+a3OnD30ahqibTIaSS/AkK5kq3hlYygza6Bn6yYkMPTxj32my2ZvC",
+	"rGhxBLJYZ/02XYyLgLuctqAdb9nOh9rXBLbENhzSEaOOR2leGERUJTUXtNvGjMerP1thDn8arLArshaJ",
+	"ry6886N33hkfsVnmGGPzJ5yNaVL054cBf6MI0c6Pa0vjKcB5zek/ntx0rNe91HCmE8bMhVL52kF8ePwM",
+	"818dhYlFNl9zayQsuKLYjo6i6DWJ05X6Nf32qNDcj2MbgpisOAmthlgw1oB6wvC9IroN4YDG48Z1Me8/",
+	"OEMH88RB6Qtuv91/oNVpJNMkd71sMxmXq4l2KoP+Nl0RQIeR0tY2pOgU3yMpdOL8s5t0omZXraC8Prof",
+	"VQiGFDlTu0qMVWjWpBsscgpVbIodwmL9sW/FJHdik21xIcbMkfuHo5osP99YXDix07K4EBE+rtxLPO3K",
+	"AhKExvU5gwLSvorHCnnK6q2UqtiJBEPAd3FBDk30RCsPiYeymadWnBSFVnyW92stIh0/ytAkOLIob752",
+	"Enz8oYCT3Ot24plS8esPOZmO5uLCmQ8uqsNuQT/2817MrARNyckm2tGG6ddxUo6u8yF0oaIHkUGqsdsI",
+	"/Kox+dEWeYIhntm4TmlTo86oS2AjqTd2SWU/zIEKjbGm7JLieFw0Cqkimq4NqPJIL1OyxmsFC56l1uIj",
+	"LC0/yrw5MAJz4d1YJE53bn7o9p1+EUKjz7RcjUxTrHNJB58ypmBQiTDUUv527OlsXvrnlEh7EOoSWgJm",
+	"I7uovUJRpWsoX8WXyZOjN++2FV7lgfLbeurlSv1EPd2MbpvMfL5Gpx05V0hjXp3LOFNoTjhNmJs9zCs3",
+	"fh0jZjOZSH7ri2fzxe/SQtX54ksYFvu6g/QLAvGwIvZ4knFF6cdZPc/cWrx5xzTOMLSoe6V7PJzMFLMv",
+	"wn8na+TpKtxTbuK9Sin7Yrp4r94du5B0MAVtJkkDM0rrFIcN39arz1Sv/kEUg9+YfEk/U3Ssjr5IZ/TD",
+	"MjSSSEoeql4XldXkLIbYhiMZtbORelxVOp0eXfq3a3IvpFyON1FOk5Rlhroua1IWRu9m50crO/nO/tvk",
+	"bCohoVDXH6gXQk/KwV75VZSTf/xhDMCLDT41n3AyyEttcwFQTzcBcHao95M58zRN/rdQ73Q/CTIB0Cu8",
+	"8P8W6L0FelOP6tlCaj66T2MQIReecqWZUdCvm2mTQlisEvSid6ujqfrR+O9udMf/x98OuAS/phMzZJ7l",
+	"xzemh2Ppx5L0IEf3Dv+LSz3lkf1xgv8uvmIx/scJms3/GwBFOyC3klcAAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -2,23 +2,14 @@ package handlers
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
-	"net/http"
+	"fmt"
 
 	"avito/internal/domain/auth"
 	"avito/internal/interfaces/http/dto"
 
-	"log/slog"
-
 	"github.com/google/uuid"
 )
 
-var (
-	ErrEmailAlreadyExists = errors.New("пользователь с таким email уже существует")
-	ErrInvalidCredentials = errors.New("неверные учетные данные")
-)
-
 type AuthService interface {
 	Register(ctx context.Context, email, password string, role auth.Role) (*auth.User, error)
 	Login(ctx context.Context, email, password string) (string, error)
@@ -27,89 +18,55 @@ type AuthService interface {
 
 type AuthHandler struct {
 	service AuthService
-	logger  *slog.Logger
 }
 
-func NewAuthHandler(service AuthService, logger *slog.Logger) *AuthHandler {
+func NewAuthHandler(service AuthService) *AuthHandler {
 	return &AuthHandler{
 		service: service,
-		logger:  logger,
 	}
 }
 
-func (h *AuthHandler) DummyLogin(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		respondWithError(w, http.StatusMethodNotAllowed, "метод не поддерживается", nil, h.logger)
-		return
-	}
-
-	var req dto.PostDummyLoginJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondWithError(w, http.StatusBadRequest, "неверный формат запроса", err, h.logger)
-		return
-	}
-
+func (h *AuthHandler) DummyLogin(ctx context.Context, request dto.DummyLoginRequestObject) (dto.DummyLoginResponseObject, error) {
 	var role auth.Role
 
-	switch req.Role {
-	case dto.PostDummyLoginJSONBodyRoleEmployee:
+	switch request.Body.Role {
+	case dto.DummyLoginJSONBodyRoleEmployee:
 		role = auth.RoleEmployee
-	case dto.PostDummyLoginJSONBodyRoleModerator:
+	case dto.DummyLoginJSONBodyRoleModerator:
 		role = auth.RoleModerator
 	default:
-		respondWithError(w, http.StatusBadRequest, "неизвестная роль", nil, h.logger)
-		return
+		return nil, &auth.ErrInvalidRole{}
 	}
 
-	token, err := h.service.GenerateDummyToken(r.Context(), role)
+	token, err := h.service.GenerateDummyToken(ctx, role)
 	if err != nil {
-		respondWithError(w, http.StatusInternalServerError, "ошибка при генерации токена", err, h.logger)
-		return
+		return nil, fmt.Errorf("ошибка при генерации токена: %w", err)
 	}
 
-	respondWithJSON(w, http.StatusOK, token)
+	return dto.DummyLogin200JSONResponse(token), nil
 }
 
-func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		respondWithError(w, http.StatusMethodNotAllowed, "метод не поддерживается", nil, h.logger)
-		return
-	}
-
-	var req dto.PostRegisterJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondWithError(w, http.StatusBadRequest, "неверный формат запроса", err, h.logger)
-		return
-	}
-
+func (h *AuthHandler) Register(ctx context.Context, request dto.RegisterRequestObject) (dto.RegisterResponseObject, error) {
 	var role auth.Role
 
-	switch req.Role {
+	switch request.Body.Role {
 	case dto.Employee:
 		role = auth.RoleEmployee
 	case dto.Moderator:
 		role = auth.RoleModerator
 	default:
-		respondWithError(w, http.StatusBadRequest, "неизвестная роль", nil, h.logger)
-		return
+		return nil, &auth.ErrInvalidRole{}
 	}
 
-	user, err := h.service.Register(r.Context(), string(req.Email), req.Password, role)
+	user, err := h.service.Register(ctx, string(request.Body.Email), request.Body.Password, role)
 	if err != nil {
-		switch {
-		case errors.Is(err, ErrEmailAlreadyExists):
-			respondWithError(w, http.StatusBadRequest, "пользователь с таким email уже существует", err, h.logger)
-		default:
-			respondWithError(w, http.StatusInternalServerError, "ошибка при регистрации пользователя", err, h.logger)
-		}
-
-		return
+		return nil, fmt.Errorf("ошибка при регистрации пользователя: %w", err)
 	}
 
 	userID, _ := uuid.Parse(user.ID.String())
 	respUser := dto.User{
 		Id:    &userID,
-		Email: req.Email,
+		Email: request.Body.Email,
 	}
 
 	switch user.Role {
@@ -119,32 +76,14 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		respUser.Role = dto.UserRoleModerator
 	}
 
-	respondWithJSON(w, http.StatusCreated, respUser)
+	return dto.Register201JSONResponse(respUser), nil
 }
 
-func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		respondWithError(w, http.StatusMethodNotAllowed, "метод не поддерживается", nil, h.logger)
-		return
-	}
-
-	var req dto.PostLoginJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondWithError(w, http.StatusBadRequest, "неверный формат запроса", err, h.logger)
-		return
-	}
-
-	token, err := h.service.Login(r.Context(), string(req.Email), req.Password)
+func (h *AuthHandler) Login(ctx context.Context, request dto.LoginRequestObject) (dto.LoginResponseObject, error) {
+	token, err := h.service.Login(ctx, string(request.Body.Email), request.Body.Password)
 	if err != nil {
-		switch {
-		case errors.Is(err, ErrInvalidCredentials):
-			respondWithError(w, http.StatusUnauthorized, "неверные учетные данные", err, h.logger)
-		default:
-			respondWithError(w, http.StatusInternalServerError, "ошибка при авторизации", err, h.logger)
-		}
-
-		return
+		return nil, fmt.Errorf("ошибка при авторизации: %w", err)
 	}
 
-	respondWithJSON(w, http.StatusOK, token)
+	return dto.Login200JSONResponse(token), nil
 }
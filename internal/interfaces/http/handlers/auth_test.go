@@ -23,7 +23,7 @@ import (
 
 func TestAuthHandler_Register(t *testing.T) {
 	type args struct {
-		request dto.PostRegisterJSONRequestBody
+		request dto.RegisterJSONRequestBody
 	}
 
 	tests := []struct {
@@ -36,7 +36,7 @@ func TestAuthHandler_Register(t *testing.T) {
 		{
 			name: "Успешная регистрация",
 			args: args{
-				request: dto.PostRegisterJSONRequestBody{
+				request: dto.RegisterJSONRequestBody{
 					Email:    "test@example.com",
 					Password: "password123",
 					Role:     dto.Employee,
@@ -68,7 +68,7 @@ func TestAuthHandler_Register(t *testing.T) {
 		{
 			name: "Пользователь уже существует",
 			args: args{
-				request: dto.PostRegisterJSONRequestBody{
+				request: dto.RegisterJSONRequestBody{
 					Email:    "existing@example.com",
 					Password: "password123",
 					Role:     dto.Employee,
@@ -76,7 +76,7 @@ func TestAuthHandler_Register(t *testing.T) {
 			},
 			setupMock: func(mockSvc *mocks.AuthService) {
 				mockSvc.On("Register", mock.Anything, "existing@example.com", "password123", auth.RoleEmployee).
-					Return(nil, handlers.ErrEmailAlreadyExists)
+					Return(nil, &auth.ErrUserAlreadyExists{})
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectedBody:   nil,
@@ -84,7 +84,7 @@ func TestAuthHandler_Register(t *testing.T) {
 		{
 			name: "Некорректная роль пользователя",
 			args: args{
-				request: dto.PostRegisterJSONRequestBody{
+				request: dto.RegisterJSONRequestBody{
 					Email:    "test@example.com",
 					Password: "password123",
 					Role:     "invalid_role",
@@ -102,7 +102,7 @@ func TestAuthHandler_Register(t *testing.T) {
 			tt.setupMock(mockService)
 
 			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
-			handler := handlers.NewAuthHandler(mockService, nullLogger)
+			handler := newTestHandler(handlers.NewServer(handlers.NewAuthHandler(mockService), nil, nil, nil), nullLogger)
 
 			requestBody, err := json.Marshal(tt.args.request)
 			require.NoError(t, err)
@@ -113,7 +113,7 @@ func TestAuthHandler_Register(t *testing.T) {
 
 			recorder := httptest.NewRecorder()
 
-			handler.Register(recorder, req)
+			handler.ServeHTTP(recorder, req)
 
 			assert.Equal(t, tt.expectedStatus, recorder.Code)
 
@@ -144,7 +144,7 @@ func TestAuthHandler_Register(t *testing.T) {
 
 func TestAuthHandler_Login(t *testing.T) {
 	type args struct {
-		request dto.PostLoginJSONRequestBody
+		request dto.LoginJSONRequestBody
 	}
 
 	tests := []struct {
@@ -157,7 +157,7 @@ func TestAuthHandler_Login(t *testing.T) {
 		{
 			name: "Успешная авторизация",
 			args: args{
-				request: dto.PostLoginJSONRequestBody{
+				request: dto.LoginJSONRequestBody{
 					Email:    "test@example.com",
 					Password: "password123",
 				},
@@ -172,14 +172,14 @@ func TestAuthHandler_Login(t *testing.T) {
 		{
 			name: "Неверные учетные данные",
 			args: args{
-				request: dto.PostLoginJSONRequestBody{
+				request: dto.LoginJSONRequestBody{
 					Email:    "wrong@example.com",
 					Password: "wrongpass",
 				},
 			},
 			setupMock: func(mockSvc *mocks.AuthService) {
 				mockSvc.On("Login", mock.Anything, "wrong@example.com", "wrongpass").
-					Return("", handlers.ErrInvalidCredentials)
+					Return("", &auth.ErrInvalidCredentials{})
 			},
 			expectedStatus: http.StatusUnauthorized,
 			expectedToken:  "",
@@ -192,7 +192,7 @@ func TestAuthHandler_Login(t *testing.T) {
 			tt.setupMock(mockService)
 
 			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
-			handler := handlers.NewAuthHandler(mockService, nullLogger)
+			handler := newTestHandler(handlers.NewServer(handlers.NewAuthHandler(mockService), nil, nil, nil), nullLogger)
 
 			requestBody, err := json.Marshal(tt.args.request)
 			require.NoError(t, err)
@@ -203,7 +203,7 @@ func TestAuthHandler_Login(t *testing.T) {
 
 			recorder := httptest.NewRecorder()
 
-			handler.Login(recorder, req)
+			handler.ServeHTTP(recorder, req)
 
 			assert.Equal(t, tt.expectedStatus, recorder.Code)
 
@@ -221,7 +221,7 @@ func TestAuthHandler_Login(t *testing.T) {
 
 func TestAuthHandler_DummyLogin(t *testing.T) {
 	type args struct {
-		request dto.PostDummyLoginJSONRequestBody
+		request dto.DummyLoginJSONRequestBody
 	}
 
 	tests := []struct {
@@ -234,8 +234,8 @@ func TestAuthHandler_DummyLogin(t *testing.T) {
 		{
 			name: "Успешное получение токена для сотрудника",
 			args: args{
-				request: dto.PostDummyLoginJSONRequestBody{
-					Role: dto.PostDummyLoginJSONBodyRoleEmployee,
+				request: dto.DummyLoginJSONRequestBody{
+					Role: dto.DummyLoginJSONBodyRoleEmployee,
 				},
 			},
 			setupMock: func(mockSvc *mocks.AuthService) {
@@ -248,8 +248,8 @@ func TestAuthHandler_DummyLogin(t *testing.T) {
 		{
 			name: "Успешное получение токена для модератора",
 			args: args{
-				request: dto.PostDummyLoginJSONRequestBody{
-					Role: dto.PostDummyLoginJSONBodyRoleModerator,
+				request: dto.DummyLoginJSONRequestBody{
+					Role: dto.DummyLoginJSONBodyRoleModerator,
 				},
 			},
 			setupMock: func(mockSvc *mocks.AuthService) {
@@ -262,7 +262,7 @@ func TestAuthHandler_DummyLogin(t *testing.T) {
 		{
 			name: "Некорректная роль",
 			args: args{
-				request: dto.PostDummyLoginJSONRequestBody{
+				request: dto.DummyLoginJSONRequestBody{
 					Role: "invalid_role",
 				},
 			},
@@ -278,7 +278,7 @@ func TestAuthHandler_DummyLogin(t *testing.T) {
 			tt.setupMock(mockService)
 
 			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
-			handler := handlers.NewAuthHandler(mockService, nullLogger)
+			handler := newTestHandler(handlers.NewServer(handlers.NewAuthHandler(mockService), nil, nil, nil), nullLogger)
 
 			requestBody, err := json.Marshal(tt.args.request)
 			require.NoError(t, err)
@@ -289,7 +289,7 @@ func TestAuthHandler_DummyLogin(t *testing.T) {
 
 			recorder := httptest.NewRecorder()
 
-			handler.DummyLogin(recorder, req)
+			handler.ServeHTTP(recorder, req)
 
 			assert.Equal(t, tt.expectedStatus, recorder.Code)
 
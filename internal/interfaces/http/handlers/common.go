@@ -1,8 +1,13 @@
 package handlers
 
 import (
-	"encoding/json"
+	"errors"
 	"net/http"
+
+	"avito/internal/domain/errcode"
+	"avito/internal/interfaces/http/dto"
+	"avito/internal/interfaces/http/problem"
+	"avito/internal/interfaces/http/requestid"
 )
 
 type Logger interface {
@@ -11,32 +16,59 @@ type Logger interface {
 	Debug(msg string, args ...any)
 }
 
-type Error struct {
-	Message string `json:"message"`
+// RequestErrorHandler отвечает 400, если тело запроса не удалось разобрать.
+func RequestErrorHandler(logger Logger) func(w http.ResponseWriter, r *http.Request, err error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		detail := errcode.Detail{Key: errcode.DetailMalformedBody, Text: "неверный формат запроса"}
+		respondWithError(w, r, http.StatusBadRequest, errcode.InvalidRequest, detail, err, logger)
+	}
 }
 
-func respondWithJSON(w http.ResponseWriter, status int, payload interface{}) {
-	response, err := json.Marshal(payload)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write([]byte(`{"message":"Ошибка при формировании ответа"}`))
+// ParamErrorHandler отвечает 400 на ошибки разбора параметров пути и строки запроса.
+func ParamErrorHandler(logger Logger) func(w http.ResponseWriter, r *http.Request, err error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		var (
+			requiredErr *dto.RequiredParamError
+			formatErr   *dto.InvalidParamFormatError
+			tooManyErr  *dto.TooManyValuesForParamError
+		)
 
-		return
-	}
+		detail := errcode.Detail{Key: errcode.DetailInvalidParams, Text: "неверные параметры запроса"}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	_, _ = w.Write(response)
+		switch {
+		case errors.As(err, &requiredErr):
+			detail = paramDetail(errcode.DetailMissingParam, "не передан параметр {name}", requiredErr.ParamName)
+		case errors.As(err, &formatErr):
+			detail = paramDetail(errcode.DetailInvalidParamFormat, "неверный формат параметра {name}", formatErr.ParamName)
+		case errors.As(err, &tooManyErr):
+			detail = paramDetail(errcode.DetailTooManyParamValues, "слишком много значений параметра {name}", tooManyErr.ParamName)
+		}
+
+		respondWithError(w, r, http.StatusBadRequest, errcode.InvalidRequest, detail, err, logger)
+	}
 }
 
-func respondWithError(w http.ResponseWriter, status int, message string, err error, logger Logger) {
-	if logger != nil {
-		logger.Error(message, "error", err, "status", status)
+// ResponseErrorHandler отвечает на ошибки, которые вернули обработчики: статус и код
+// определяются по доменной ошибке в mapError.
+func ResponseErrorHandler(logger Logger) func(w http.ResponseWriter, r *http.Request, err error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		status, code, detail := mapError(err)
+		respondWithError(w, r, status, code, detail, err, logger)
 	}
+}
+
+// paramDetail возвращает сообщение об ошибке параметра name по русскому шаблону с параметром {name}.
+func paramDetail(key errcode.DetailKey, template, name string) errcode.Detail {
+	params := map[string]string{"name": name}
 
-	errorResponse := Error{
-		Message: message,
+	return errcode.Detail{Key: key, Params: params, Text: errcode.Format(template, params)}
+}
+
+func respondWithError(w http.ResponseWriter, r *http.Request, status int, code errcode.Code, detail errcode.Detail, err error,
+	logger Logger) {
+	if logger != nil {
+		logger.Error(detail.Text, "error", err, "status", status, "code", code, "request_id", requestid.FromContext(r.Context()))
 	}
 
-	respondWithJSON(w, status, errorResponse)
+	problem.Write(w, problem.New(r.Context(), status, code, detail))
 }
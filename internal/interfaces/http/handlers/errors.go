@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+
+	"avito/internal/domain/auth"
+	"avito/internal/domain/cursor"
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/event"
+	"avito/internal/domain/product"
+	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
+	"avito/internal/interfaces/apierr"
+)
+
+const internalErrorMessage = "внутренняя ошибка сервера"
+
+// statusByCode сопоставляет коды доменных ошибок из apierr с HTTP-статусами.
+// Нарушения бизнес-правил приемки отдаются как 400, как того требует спецификация API.
+var statusByCode = map[errcode.Code]int{
+	pvz.CodePVZNotFound:             http.StatusNotFound,
+	reception.CodeReceptionNotFound: http.StatusNotFound,
+	product.CodeProductNotFound:     http.StatusNotFound,
+	auth.CodeUserNotFound:           http.StatusNotFound,
+
+	reception.CodeReceptionAlreadyOpen: http.StatusBadRequest,
+	reception.CodeNoActiveReception:    http.StatusBadRequest,
+	reception.CodeReceptionClosed:      http.StatusBadRequest,
+	product.CodeNoProductsToDelete:     http.StatusBadRequest,
+	auth.CodeUserAlreadyExists:         http.StatusBadRequest,
+
+	auth.CodeInvalidCredentials: http.StatusUnauthorized,
+	errcode.Unauthenticated:     http.StatusUnauthorized,
+
+	pvz.CodeInvalidCity:             http.StatusBadRequest,
+	pvz.CodeCityEmpty:               http.StatusBadRequest,
+	pvz.CodeInvalidPaginationParams: http.StatusBadRequest,
+	cursor.CodeInvalid:              http.StatusBadRequest,
+	product.CodeInvalidProductType:  http.StatusBadRequest,
+	product.CodeTypeEmpty:           http.StatusBadRequest,
+	auth.CodeInvalidRole:            http.StatusBadRequest,
+	auth.CodeEmailEmpty:             http.StatusBadRequest,
+	auth.CodePasswordEmpty:          http.StatusBadRequest,
+	auth.CodeRoleEmpty:              http.StatusBadRequest,
+	errcode.ValidationFailed:        http.StatusBadRequest,
+
+	event.CodeCursorExpired:     http.StatusGone,
+	event.CodeSubscriberTooSlow: http.StatusServiceUnavailable,
+}
+
+// mapError возвращает статус, код и подробное сообщение для доменной ошибки.
+// Клиенту уходит сообщение самой доменной ошибки без контекста обертки.
+// Неизвестные ошибки не раскрываются и превращаются в 500.
+func mapError(err error) (int, errcode.Code, errcode.Detail) {
+	if domainErr, code, ok := apierr.Find(err); ok {
+		if status, ok := statusByCode[code]; ok {
+			return status, code, errcode.DetailOf(domainErr)
+		}
+	}
+
+	return http.StatusInternalServerError, errcode.Internal, errcode.Detail{Text: internalErrorMessage}
+}
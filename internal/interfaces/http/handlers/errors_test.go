@@ -0,0 +1,123 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"avito/internal/domain/auth"
+	"avito/internal/domain/cursor"
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/event"
+	"avito/internal/domain/product"
+	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
+	"avito/internal/interfaces/http/dto"
+	"avito/internal/interfaces/http/handlers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseErrorHandler_DomainErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   errcode.Code
+	}{
+		{name: "ПВЗ не найден", err: &pvz.ErrPVZNotFound{}, expectedStatus: http.StatusNotFound, expectedCode: pvz.CodePVZNotFound},
+		{name: "Приемка не найдена", err: &reception.ErrReceptionNotFound{},
+			expectedStatus: http.StatusNotFound, expectedCode: reception.CodeReceptionNotFound},
+		{name: "Товар не найден", err: &product.ErrProductNotFound{},
+			expectedStatus: http.StatusNotFound, expectedCode: product.CodeProductNotFound},
+		{name: "Пользователь не найден", err: &auth.ErrUserNotFound{},
+			expectedStatus: http.StatusNotFound, expectedCode: auth.CodeUserNotFound},
+
+		{name: "Уже есть незакрытая приемка", err: &reception.ErrActiveReceptionExists{},
+			expectedStatus: http.StatusBadRequest, expectedCode: reception.CodeReceptionAlreadyOpen},
+		{name: "Нет активной приемки", err: &reception.ErrNoActiveReception{},
+			expectedStatus: http.StatusBadRequest, expectedCode: reception.CodeNoActiveReception},
+		{name: "Приемка закрыта", err: &reception.ErrReceptionClosed{},
+			expectedStatus: http.StatusBadRequest, expectedCode: reception.CodeReceptionClosed},
+		{name: "Нет товаров для удаления", err: &product.ErrNoProductsToDelete{},
+			expectedStatus: http.StatusBadRequest, expectedCode: product.CodeNoProductsToDelete},
+		{name: "Пользователь уже существует", err: &auth.ErrUserAlreadyExists{},
+			expectedStatus: http.StatusBadRequest, expectedCode: auth.CodeUserAlreadyExists},
+
+		{name: "Неверные учетные данные", err: &auth.ErrInvalidCredentials{},
+			expectedStatus: http.StatusUnauthorized, expectedCode: auth.CodeInvalidCredentials},
+		{name: "Пустой токен", err: &auth.ErrEmptyToken{},
+			expectedStatus: http.StatusUnauthorized, expectedCode: errcode.Unauthenticated},
+
+		{name: "Неверный город", err: &pvz.ErrInvalidCity{},
+			expectedStatus: http.StatusBadRequest, expectedCode: pvz.CodeInvalidCity},
+		{name: "Пустой город", err: &pvz.ErrCityEmpty{},
+			expectedStatus: http.StatusBadRequest, expectedCode: pvz.CodeCityEmpty},
+		{name: "Неверная пагинация", err: &pvz.ErrInvalidPaginationParams{},
+			expectedStatus: http.StatusBadRequest, expectedCode: pvz.CodeInvalidPaginationParams},
+		{name: "Неверный курсор", err: &cursor.ErrInvalid{},
+			expectedStatus: http.StatusBadRequest, expectedCode: cursor.CodeInvalid},
+		{name: "Неверный тип товара", err: &product.ErrInvalidProductType{},
+			expectedStatus: http.StatusBadRequest, expectedCode: product.CodeInvalidProductType},
+		{name: "Пустой тип товара", err: &product.ErrTypeEmpty{},
+			expectedStatus: http.StatusBadRequest, expectedCode: product.CodeTypeEmpty},
+		{name: "Неверная роль", err: &auth.ErrInvalidRole{},
+			expectedStatus: http.StatusBadRequest, expectedCode: auth.CodeInvalidRole},
+		{name: "Пустой email", err: &auth.ErrEmailEmpty{},
+			expectedStatus: http.StatusBadRequest, expectedCode: auth.CodeEmailEmpty},
+		{name: "Пустой пароль", err: &auth.ErrPasswordEmpty{},
+			expectedStatus: http.StatusBadRequest, expectedCode: auth.CodePasswordEmpty},
+		{name: "Пустая роль", err: &auth.ErrRoleEmpty{},
+			expectedStatus: http.StatusBadRequest, expectedCode: auth.CodeRoleEmpty},
+		{name: "Ошибка валидации ПВЗ", err: &pvz.ValidationError{Key: pvz.DetailEmptyIDs},
+			expectedStatus: http.StatusBadRequest, expectedCode: errcode.ValidationFailed},
+		{name: "Ошибка валидации приемки", err: &reception.ValidationError{Message: "неверная приемка"},
+			expectedStatus: http.StatusBadRequest, expectedCode: errcode.ValidationFailed},
+		{name: "Ошибка валидации товара", err: &product.ValidationError{Key: product.DetailUnknownScanCommand},
+			expectedStatus: http.StatusBadRequest, expectedCode: errcode.ValidationFailed},
+		{name: "Ошибка валидации пользователя", err: &auth.ValidationError{Message: "неверный пользователь"},
+			expectedStatus: http.StatusBadRequest, expectedCode: errcode.ValidationFailed},
+
+		{name: "Курсор событий устарел", err: &event.ErrCursorExpired{},
+			expectedStatus: http.StatusGone, expectedCode: event.CodeCursorExpired},
+		{name: "Подписчик не успевает", err: &event.ErrSubscriberTooSlow{},
+			expectedStatus: http.StatusServiceUnavailable, expectedCode: event.CodeSubscriberTooSlow},
+
+		{name: "Неизвестная ошибка", err: errors.New("connection refused"),
+			expectedStatus: http.StatusInternalServerError, expectedCode: errcode.Internal},
+		{name: "Отмена контекста", err: context.Canceled,
+			expectedStatus: http.StatusInternalServerError, expectedCode: errcode.Internal},
+	}
+
+	handle := handlers.ResponseErrorHandler(slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil)))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Обработчики оборачивают ошибки сервисов контекстом, код и статус от этого не меняются.
+			for _, err := range []error{tt.err, fmt.Errorf("ошибка в обработчике: %w", tt.err)} {
+				recorder := httptest.NewRecorder()
+
+				handle(recorder, httptest.NewRequest(http.MethodGet, "/", http.NoBody), err)
+
+				assert.Equal(t, tt.expectedStatus, recorder.Code)
+
+				var body dto.Error
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+				assert.Equal(t, string(tt.expectedCode), body.Code)
+
+				if tt.expectedStatus == http.StatusInternalServerError {
+					assert.Equal(t, "внутренняя ошибка сервера", body.Detail)
+				} else {
+					assert.Equal(t, tt.err.Error(), body.Detail)
+				}
+			}
+		})
+	}
+}
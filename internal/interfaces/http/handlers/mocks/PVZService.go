@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	pvz "avito/internal/domain/pvz"
+
+	uuid "github.com/google/uuid"
 )
 
 // PVZService is an autogenerated mock type for the PVZService type
@@ -45,24 +47,54 @@ func (_m *PVZService) CreatePVZ(ctx context.Context, req pvz.CreatePVZRequest) (
 	return r0, r1
 }
 
+// GetPVZByID provides a mock function with given fields: ctx, id
+func (_m *PVZService) GetPVZByID(ctx context.Context, id uuid.UUID) (*pvz.WithReceptions, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPVZByID")
+	}
+
+	var r0 *pvz.WithReceptions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*pvz.WithReceptions, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *pvz.WithReceptions); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pvz.WithReceptions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPVZs provides a mock function with given fields: ctx, req
-func (_m *PVZService) GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) ([]pvz.WithReceptions, error) {
+func (_m *PVZService) GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) (*pvz.Page, error) {
 	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPVZs")
 	}
 
-	var r0 []pvz.WithReceptions
+	var r0 *pvz.Page
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pvz.GetPVZsRequest) ([]pvz.WithReceptions, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, pvz.GetPVZsRequest) (*pvz.Page, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pvz.GetPVZsRequest) []pvz.WithReceptions); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, pvz.GetPVZsRequest) *pvz.Page); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]pvz.WithReceptions)
+			r0 = ret.Get(0).(*pvz.Page)
 		}
 	}
 
@@ -75,6 +107,36 @@ func (_m *PVZService) GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) ([]pv
 	return r0, r1
 }
 
+// GetPVZsByIDs provides a mock function with given fields: ctx, ids
+func (_m *PVZService) GetPVZsByIDs(ctx context.Context, ids []uuid.UUID) ([]pvz.WithReceptions, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPVZsByIDs")
+	}
+
+	var r0 []pvz.WithReceptions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]pvz.WithReceptions, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []pvz.WithReceptions); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pvz.WithReceptions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewPVZService creates a new instance of PVZService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPVZService(t interface {
@@ -65,6 +65,36 @@ func (_m *ProductService) DeleteLastProduct(ctx context.Context, pvzID uuid.UUID
 	return r0
 }
 
+// ListProducts provides a mock function with given fields: ctx, req
+func (_m *ProductService) ListProducts(ctx context.Context, req product.ListRequest) (*product.Page, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProducts")
+	}
+
+	var r0 *product.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, product.ListRequest) (*product.Page, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, product.ListRequest) *product.Page); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*product.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, product.ListRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewProductService creates a new instance of ProductService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProductService(t interface {
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// writePage отдает страницу списка. Сгенерированные 200-ответы выставляют все объявленные заголовки
+// даже без значения, а заголовки пагинации должны появляться только когда они есть.
+func writePage(w http.ResponseWriter, body any, nextCursor, link string, total *int) error {
+	if nextCursor != "" {
+		w.Header().Set("X-Next-Cursor", nextCursor)
+		w.Header().Set("Link", link)
+	}
+
+	if total != nil {
+		w.Header().Set("X-Total-Count", strconv.Itoa(*total))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	return json.NewEncoder(w).Encode(body)
+}
+
+// nextLink возвращает значение заголовка Link со ссылкой на следующую страницу.
+// Ссылка относительная и повторяет параметры текущего запроса, к которым добавляется курсор.
+func nextLink(path string, query url.Values, cursor string) string {
+	query.Set("cursor", cursor)
+
+	return fmt.Sprintf(`<%s?%s>; rel="next"`, path, query.Encode())
+}
+
+// joinValues собирает значения параметра-массива через запятую, как их ожидает спецификация (explode: false).
+func joinValues[T ~string](values []T) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, string(v))
+	}
+
+	return strings.Join(parts, ",")
+}
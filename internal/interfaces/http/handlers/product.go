@@ -2,88 +2,53 @@ package handlers
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
+	"fmt"
 	"net/http"
-	"strings"
+	"net/url"
+	"strconv"
 
 	"avito/internal/domain/product"
+	"avito/internal/domain/pvz"
 	"avito/internal/interfaces/http/dto"
 	"avito/internal/metrics"
 
-	"log/slog"
-
 	"github.com/google/uuid"
 )
 
-var (
-	ErrNoActiveReceptionProduct  = errors.New("нет активной приемки")
-	ErrNoProductsToDelete        = errors.New("нет товаров для удаления")
-	ErrReceptionClosedForProduct = errors.New("приемка уже закрыта")
-)
-
 type ProductService interface {
 	CreateProduct(ctx context.Context, pvzID uuid.UUID, productType product.Type) (*product.Product, error)
 	DeleteLastProduct(ctx context.Context, pvzID uuid.UUID) error
+	ListProducts(ctx context.Context, req product.ListRequest) (*product.Page, error)
 }
 
 type ProductHandler struct {
 	service ProductService
-	logger  *slog.Logger
 }
 
-func NewProductHandler(service ProductService, logger *slog.Logger) *ProductHandler {
+func NewProductHandler(service ProductService) *ProductHandler {
 	return &ProductHandler{
 		service: service,
-		logger:  logger,
 	}
 }
 
-func (h *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		respondWithError(w, http.StatusMethodNotAllowed, "метод не поддерживается", nil, h.logger)
-		return
-	}
-
-	var req dto.PostProductsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondWithError(w, http.StatusBadRequest, "неверный формат запроса", err, h.logger)
-		return
-	}
-
-	pvzID, err := uuid.Parse(req.PvzId.String())
-	if err != nil {
-		respondWithError(w, http.StatusBadRequest, "неверный формат UUID для pvzId", err, h.logger)
-		return
-	}
-
+func (h *ProductHandler) CreateProduct(ctx context.Context,
+	request dto.CreateProductRequestObject) (dto.CreateProductResponseObject, error) {
 	var productType product.Type
 
-	//nolint:exhaustive // Обрабатываем только известные типы товаров
-	switch req.Type {
-	case dto.PostProductsJSONBodyType("электроника"):
+	switch request.Body.Type {
+	case dto.CreateProductJSONBodyTypeЭлектроника:
 		productType = product.TypeElectronics
-	case dto.PostProductsJSONBodyType("одежда"):
+	case dto.CreateProductJSONBodyTypeОдежда:
 		productType = product.TypeClothes
-	case dto.PostProductsJSONBodyType("обувь"):
+	case dto.CreateProductJSONBodyTypeОбувь:
 		productType = product.TypeShoes
 	default:
-		respondWithError(w, http.StatusBadRequest, "неизвестный тип товара", nil, h.logger)
-		return
+		return nil, &product.ErrInvalidProductType{}
 	}
 
-	newProduct, err := h.service.CreateProduct(r.Context(), pvzID, productType)
+	newProduct, err := h.service.CreateProduct(ctx, request.Body.PvzId, productType)
 	if err != nil {
-		switch {
-		case errors.Is(err, ErrNoActiveReceptionProduct):
-			respondWithError(w, http.StatusBadRequest, "нет активной приемки", err, h.logger)
-		case errors.Is(err, ErrReceptionClosedForProduct):
-			respondWithError(w, http.StatusBadRequest, "приемка закрыта, нельзя добавлять товары", err, h.logger)
-		default:
-			respondWithError(w, http.StatusInternalServerError, "ошибка при создании товара", err, h.logger)
-		}
-
-		return
+		return nil, fmt.Errorf("ошибка при создании товара: %w", err)
 	}
 
 	metrics.ProductsAddedTotal.Inc()
@@ -92,7 +57,7 @@ func (h *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
 	receptionID, _ := uuid.Parse(newProduct.ReceptionID.String())
 	dateTime := newProduct.DateTime
 
-	response := dto.Product{
+	response := dto.CreateProduct201JSONResponse{
 		Id:          &productID,
 		DateTime:    &dateTime,
 		ReceptionId: receptionID,
@@ -107,52 +72,98 @@ func (h *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
 		response.Type = dto.ProductType("обувь")
 	}
 
-	respondWithJSON(w, http.StatusCreated, response)
+	return response, nil
 }
 
-func (h *ProductHandler) DeleteLastProduct(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		respondWithError(w, http.StatusMethodNotAllowed, "метод не поддерживается", nil, h.logger)
-		return
+func (h *ProductHandler) DeleteLastProduct(ctx context.Context,
+	request dto.DeleteLastProductRequestObject) (dto.DeleteLastProductResponseObject, error) {
+	err := h.service.DeleteLastProduct(ctx, request.PvzId)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при удалении товара: %w", err)
 	}
 
-	path := r.URL.Path
-	h.logger.Info("Получен запрос на удаление последнего товара", "path", path)
+	return dto.DeleteLastProduct200Response{}, nil
+}
 
-	trimmedPath := strings.Trim(path, "/")
-	parts := strings.Split(trimmedPath, "/")
+// GetReceptionProducts возвращает товары приемки по порядку добавления с пагинацией курсором.
+func (h *ProductHandler) GetReceptionProducts(ctx context.Context,
+	request dto.GetReceptionProductsRequestObject) (dto.GetReceptionProductsResponseObject, error) {
+	req := product.ListRequest{
+		ReceptionID: request.ReceptionId,
+		Limit:       product.DefaultPageSize,
+	}
+
+	query := url.Values{}
 
-	h.logger.Info("Разбор пути", "parts", parts, "count", len(parts))
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 || *request.Params.Limit > product.MaxPageSize {
+			return nil, &pvz.ErrInvalidPaginationParams{}
+		}
 
-	if len(parts) != 3 || parts[0] != "pvz" || parts[2] != "delete_last_product" {
-		respondWithError(w, http.StatusBadRequest, "неверный URL", nil, h.logger)
-		return
+		req.Limit = *request.Params.Limit
+		query.Set("limit", strconv.Itoa(req.Limit))
 	}
 
-	pvzIDStr := parts[1]
-	h.logger.Info("Извлечен ID PVZ", "pvzID", pvzIDStr)
+	if request.Params.Cursor != nil {
+		cursor, err := product.DecodeCursor(*request.Params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		req.After = cursor
+	}
 
-	pvzID, err := uuid.Parse(pvzIDStr)
+	page, err := h.service.ListProducts(ctx, req)
 	if err != nil {
-		respondWithError(w, http.StatusBadRequest, "неверный формат UUID", err, h.logger)
-		return
+		return nil, fmt.Errorf("ошибка при получении товаров приемки: %w", err)
 	}
 
-	err = h.service.DeleteLastProduct(r.Context(), pvzID)
-	if err != nil {
-		switch {
-		case errors.Is(err, ErrNoActiveReceptionProduct):
-			respondWithError(w, http.StatusBadRequest, "нет активной приемки", err, h.logger)
-		case errors.Is(err, ErrNoProductsToDelete):
-			respondWithError(w, http.StatusBadRequest, "нет товаров для удаления", err, h.logger)
-		case errors.Is(err, ErrReceptionClosedForProduct):
-			respondWithError(w, http.StatusBadRequest, "приемка уже закрыта", err, h.logger)
-		default:
-			respondWithError(w, http.StatusInternalServerError, "ошибка при удалении товара", err, h.logger)
-		}
+	response := productPageResponse{
+		body: make([]dto.Product, 0, len(page.Items)),
+	}
 
-		return
+	for _, pr := range page.Items {
+		response.body = append(response.body, productToDTO(pr))
 	}
 
-	w.WriteHeader(http.StatusOK)
+	if page.Next != nil {
+		response.nextCursor = page.Next.Encode()
+		response.link = nextLink(fmt.Sprintf("/receptions/%s/products", request.ReceptionId), query, response.nextCursor)
+	}
+
+	return response, nil
+}
+
+type productPageResponse struct {
+	body       []dto.Product
+	nextCursor string
+	link       string
+}
+
+func (r productPageResponse) VisitGetReceptionProductsResponse(w http.ResponseWriter) error {
+	return writePage(w, r.body, r.nextCursor, r.link, nil)
+}
+
+func productToDTO(pr product.Product) dto.Product {
+	prID, _ := uuid.Parse(pr.ID.String())
+	recID, _ := uuid.Parse(pr.ReceptionID.String())
+
+	var productType dto.ProductType
+
+	//nolint:exhaustive // Обрабатываем только известные типы товаров
+	switch pr.Type {
+	case "электроника":
+		productType = dto.ProductType("электроника")
+	case "одежда":
+		productType = dto.ProductType("одежда")
+	case "обувь":
+		productType = dto.ProductType("обувь")
+	}
+
+	return dto.Product{
+		Id:          &prID,
+		DateTime:    &pr.DateTime,
+		Type:        productType,
+		ReceptionId: recID,
+	}
 }
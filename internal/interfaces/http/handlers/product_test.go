@@ -9,7 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"avito/internal/domain/cursor"
+	"avito/internal/domain/errcode"
 	"avito/internal/domain/product"
+	"avito/internal/domain/reception"
 	"avito/internal/interfaces/http/dto"
 	"avito/internal/interfaces/http/handlers"
 	"avito/internal/interfaces/http/handlers/mocks"
@@ -24,7 +27,7 @@ import (
 
 func TestProductHandler_CreateProduct(t *testing.T) {
 	type args struct {
-		request dto.PostProductsJSONRequestBody
+		request dto.CreateProductJSONRequestBody
 	}
 
 	tests := []struct {
@@ -37,9 +40,9 @@ func TestProductHandler_CreateProduct(t *testing.T) {
 		{
 			name: "Успешное создание товара",
 			args: args{
-				request: dto.PostProductsJSONRequestBody{
+				request: dto.CreateProductJSONRequestBody{
 					PvzId: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
-					Type:  dto.PostProductsJSONBodyType("электроника"),
+					Type:  dto.CreateProductJSONBodyType("электроника"),
 				},
 			},
 			setupMock: func(mockSvc *mocks.ProductService) {
@@ -68,16 +71,16 @@ func TestProductHandler_CreateProduct(t *testing.T) {
 		{
 			name: "Нет активной приемки",
 			args: args{
-				request: dto.PostProductsJSONRequestBody{
+				request: dto.CreateProductJSONRequestBody{
 					PvzId: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
-					Type:  dto.PostProductsJSONBodyType("одежда"),
+					Type:  dto.CreateProductJSONBodyType("одежда"),
 				},
 			},
 			setupMock: func(mockSvc *mocks.ProductService) {
 				mockSvc.On("CreateProduct", mock.Anything,
 					uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
 					product.TypeClothes).
-					Return(nil, handlers.ErrNoActiveReceptionProduct)
+					Return(nil, &reception.ErrNoActiveReception{})
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectedBody:   nil,
@@ -85,9 +88,9 @@ func TestProductHandler_CreateProduct(t *testing.T) {
 		{
 			name: "Неизвестный тип товара",
 			args: args{
-				request: dto.PostProductsJSONRequestBody{
+				request: dto.CreateProductJSONRequestBody{
 					PvzId: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
-					Type:  dto.PostProductsJSONBodyType("мебель"),
+					Type:  dto.CreateProductJSONBodyType("мебель"),
 				},
 			},
 			setupMock: func(mockSvc *mocks.ProductService) {
@@ -104,7 +107,7 @@ func TestProductHandler_CreateProduct(t *testing.T) {
 			tt.setupMock(mockService)
 
 			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
-			handler := handlers.NewProductHandler(mockService, nullLogger)
+			handler := newTestHandler(handlers.NewServer(nil, nil, nil, handlers.NewProductHandler(mockService)), nullLogger)
 
 			requestBody, err := json.Marshal(tt.args.request)
 			require.NoError(t, err)
@@ -115,7 +118,7 @@ func TestProductHandler_CreateProduct(t *testing.T) {
 
 			recorder := httptest.NewRecorder()
 
-			handler.CreateProduct(recorder, req)
+			handler.ServeHTTP(recorder, req)
 
 			assert.Equal(t, tt.expectedStatus, recorder.Code)
 
@@ -172,7 +175,7 @@ func TestProductHandler_DeleteLastProduct(t *testing.T) {
 			url:  "/pvz/" + pvzID.String() + "/delete_last_product",
 			setupMock: func(mockSvc *mocks.ProductService) {
 				mockSvc.On("DeleteLastProduct", mock.Anything, pvzID).
-					Return(handlers.ErrNoActiveReceptionProduct)
+					Return(&reception.ErrNoActiveReception{})
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
@@ -181,13 +184,13 @@ func TestProductHandler_DeleteLastProduct(t *testing.T) {
 			url:  "/pvz/" + pvzID.String() + "/delete_last_product",
 			setupMock: func(mockSvc *mocks.ProductService) {
 				mockSvc.On("DeleteLastProduct", mock.Anything, pvzID).
-					Return(handlers.ErrNoProductsToDelete)
+					Return(&product.ErrNoProductsToDelete{})
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
-			name: "Неверный URL",
-			url:  "/invalid/url",
+			name: "Неверный UUID",
+			url:  "/pvz/invalid-uuid/delete_last_product",
 			setupMock: func(mockSvc *mocks.ProductService) {
 				// Метод не должен вызываться
 			},
@@ -201,14 +204,14 @@ func TestProductHandler_DeleteLastProduct(t *testing.T) {
 			tt.setupMock(mockService)
 
 			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
-			handler := handlers.NewProductHandler(mockService, nullLogger)
+			handler := newTestHandler(handlers.NewServer(nil, nil, nil, handlers.NewProductHandler(mockService)), nullLogger)
 
 			req, err := http.NewRequest(http.MethodPost, tt.url, http.NoBody)
 			require.NoError(t, err)
 
 			recorder := httptest.NewRecorder()
 
-			handler.DeleteLastProduct(recorder, req)
+			handler.ServeHTTP(recorder, req)
 
 			assert.Equal(t, tt.expectedStatus, recorder.Code)
 
@@ -216,3 +219,101 @@ func TestProductHandler_DeleteLastProduct(t *testing.T) {
 		})
 	}
 }
+
+func TestProductHandler_GetReceptionProducts(t *testing.T) {
+	recID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	now := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	next := product.Cursor{SequenceNumber: 2}
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(mockSvc *mocks.ProductService)
+		expectedStatus int
+		expectedCode   errcode.Code
+		expectedItems  int
+		expectedCursor string
+	}{
+		{
+			name:  "Страница с курсором следующей страницы",
+			query: "?limit=2",
+			setupMock: func(mockSvc *mocks.ProductService) {
+				mockSvc.On("ListProducts", mock.Anything, product.ListRequest{ReceptionID: recID, Limit: 2}).
+					Return(&product.Page{
+						Items: []product.Product{
+							{ID: uuid.New(), DateTime: now, Type: product.TypeShoes, ReceptionID: recID, SequenceNumber: 1},
+							{ID: uuid.New(), DateTime: now, Type: product.TypeClothes, ReceptionID: recID, SequenceNumber: 2},
+						},
+						Next: &next,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedItems:  2,
+			expectedCursor: next.Encode(),
+		},
+		{
+			name:  "Страница по курсору",
+			query: "?cursor=" + next.Encode(),
+			setupMock: func(mockSvc *mocks.ProductService) {
+				mockSvc.On("ListProducts", mock.Anything, product.ListRequest{
+					ReceptionID: recID,
+					After:       &next,
+					Limit:       product.DefaultPageSize,
+				}).Return(&product.Page{Items: []product.Product{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedItems:  0,
+		},
+		{
+			name:           "Некорректный курсор",
+			query:          "?cursor=не-курсор",
+			setupMock:      func(mockSvc *mocks.ProductService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   cursor.CodeInvalid,
+		},
+		{
+			name:  "Приемка не найдена",
+			query: "",
+			setupMock: func(mockSvc *mocks.ProductService) {
+				mockSvc.On("ListProducts", mock.Anything, product.ListRequest{ReceptionID: recID, Limit: product.DefaultPageSize}).
+					Return(nil, &reception.ErrReceptionNotFound{})
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   reception.CodeReceptionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.ProductService)
+			tt.setupMock(mockService)
+
+			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
+			handler := newTestHandler(handlers.NewServer(nil, nil, nil, handlers.NewProductHandler(mockService)), nullLogger)
+
+			req, err := http.NewRequest(http.MethodGet, "/receptions/"+recID.String()+"/products"+tt.query, http.NoBody)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var body []dto.Product
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+				assert.Len(t, body, tt.expectedItems)
+				assert.Equal(t, tt.expectedCursor, recorder.Header().Get("X-Next-Cursor"))
+			}
+
+			if tt.expectedCode != "" {
+				var problem dto.Error
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+				assert.Equal(t, string(tt.expectedCode), problem.Code)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -2,48 +2,44 @@ package handlers
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
 	"time"
 
+	"avito/internal/domain/product"
 	"avito/internal/domain/pvz"
+	"avito/internal/domain/reception"
 	"avito/internal/interfaces/http/dto"
 	"avito/internal/metrics"
 
-	"log/slog"
-
 	"github.com/google/uuid"
 )
 
 type PVZService interface {
 	CreatePVZ(ctx context.Context, req pvz.CreatePVZRequest) (*pvz.PVZ, error)
-	GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) ([]pvz.WithReceptions, error)
+	GetPVZs(ctx context.Context, req pvz.GetPVZsRequest) (*pvz.Page, error)
+	GetPVZByID(ctx context.Context, id uuid.UUID) (*pvz.WithReceptions, error)
+	GetPVZsByIDs(ctx context.Context, ids []uuid.UUID) ([]pvz.WithReceptions, error)
 }
 
 type PVZHandler struct {
 	service PVZService
-	logger  *slog.Logger
 }
 
-func NewPVZHandler(service PVZService, logger *slog.Logger) *PVZHandler {
+func NewPVZHandler(service PVZService) *PVZHandler {
 	return &PVZHandler{
 		service: service,
-		logger:  logger,
 	}
 }
 
-func (h *PVZHandler) CreatePVZ(w http.ResponseWriter, r *http.Request) {
-	var req dto.PostPvzJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondWithError(w, http.StatusBadRequest, "неверный формат запроса", err, h.logger)
-		return
-	}
-
+func (h *PVZHandler) CreatePVZ(ctx context.Context, request dto.CreatePVZRequestObject) (dto.CreatePVZResponseObject, error) {
 	var city pvz.City
 
 	//nolint:exhaustive // Обрабатываем только известные города
-	switch req.City {
+	switch request.Body.City {
 	case dto.PVZCity("Москва"):
 		city = pvz.CityMoscow
 	case dto.PVZCity("Санкт-Петербург"):
@@ -51,26 +47,22 @@ func (h *PVZHandler) CreatePVZ(w http.ResponseWriter, r *http.Request) {
 	case dto.PVZCity("Казань"):
 		city = pvz.CityKazan
 	default:
-		respondWithError(w, http.StatusBadRequest, "В этом городе нельзя открыть ПВЗ", nil, h.logger)
-		return
+		return nil, &pvz.ErrInvalidCity{}
 	}
 
 	createReq := pvz.CreatePVZRequest{
 		City: city,
 	}
 
-	newPVZ, err := h.service.CreatePVZ(r.Context(), createReq)
+	newPVZ, err := h.service.CreatePVZ(ctx, createReq)
 	if err != nil {
-		h.logger.Error("Ошибка при создании ПВЗ", "error", err)
-		respondWithError(w, http.StatusBadRequest, "Неверный запрос", err, h.logger)
-
-		return
+		return nil, fmt.Errorf("ошибка при создании ПВЗ: %w", err)
 	}
 
 	metrics.PVZCreatedTotal.Inc()
 
 	id, _ := uuid.Parse(newPVZ.ID.String())
-	response := dto.PVZ{
+	response := dto.CreatePVZ201JSONResponse{
 		Id:               &id,
 		RegistrationDate: &newPVZ.RegistrationDate,
 	}
@@ -84,181 +76,329 @@ func (h *PVZHandler) CreatePVZ(w http.ResponseWriter, r *http.Request) {
 		response.City = dto.PVZCity("Казань")
 	}
 
-	respondWithJSON(w, http.StatusCreated, response)
+	return response, nil
 }
 
-// разбиение усложнит поддержку
-//
-//nolint:gocognit,funlen // whyNoLint: HTTP-обработчик содержит множество проверок входных параметров из разных источников,
-func (h *PVZHandler) GetPVZs(w http.ResponseWriter, r *http.Request) {
-	query := r.URL.Query()
+func (h *PVZHandler) GetPVZs(ctx context.Context, request dto.GetPVZsRequestObject) (dto.GetPVZsResponseObject, error) {
+	req, err := getPVZsRequestFromParams(request.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.service.GetPVZs(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении списка ПВЗ: %w", err)
+	}
 
-	var startDate, endDate *time.Time
+	response := pvzPageResponse{
+		body:  make([]dto.PVZWithReceptions, 0, len(page.Items)),
+		total: page.Total,
+	}
 
-	if sd := query.Get("startDate"); sd != "" {
-		parsedDate, err := time.Parse(time.RFC3339, sd)
-		if err != nil {
-			respondWithError(w, http.StatusBadRequest, "неверный формат начальной даты", err, h.logger)
-			return
+	for _, p := range page.Items {
+		response.body = append(response.body, pvzWithReceptionsToDTO(p))
+	}
+
+	if page.Next != nil {
+		response.nextCursor = page.Next.Encode()
+		response.link = nextLink("/pvz", pvzPageQuery(request.Params), response.nextCursor)
+	}
+
+	return response, nil
+}
+
+func getPVZsRequestFromParams(params dto.GetPVZsParams) (pvz.GetPVZsRequest, error) {
+	req := pvz.GetPVZsRequest{
+		StartDate: params.StartDate,
+		EndDate:   params.EndDate,
+		Page:      1,
+		Limit:     pvz.DefaultPageSize,
+	}
+
+	if params.Page != nil {
+		if *params.Page < 1 {
+			return pvz.GetPVZsRequest{}, &pvz.ErrInvalidPaginationParams{}
 		}
 
-		startDate = &parsedDate
+		req.Page = *params.Page
 	}
 
-	if ed := query.Get("endDate"); ed != "" {
-		parsedDate, err := time.Parse(time.RFC3339, ed)
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > pvz.MaxPageSize {
+			return pvz.GetPVZsRequest{}, &pvz.ErrInvalidPaginationParams{}
+		}
+
+		req.Limit = *params.Limit
+	}
+
+	if params.Cursor != nil {
+		cursor, err := pvz.DecodeCursor(*params.Cursor)
 		if err != nil {
-			respondWithError(w, http.StatusBadRequest, "неверный формат конечной даты", err, h.logger)
-			return
+			return pvz.GetPVZsRequest{}, err
 		}
 
-		endDate = &parsedDate
+		req.After = cursor
 	}
 
-	page := 1
+	if params.WithTotal != nil {
+		req.WithTotal = *params.WithTotal
+	}
 
-	if p := query.Get("page"); p != "" {
-		parsedPage, err := strconv.Atoi(p)
-		if err != nil || parsedPage < 1 {
-			respondWithError(w, http.StatusBadRequest, "неверный параметр page", err, h.logger)
-			return
-		}
+	if err := filtersFromParams(params, &req); err != nil {
+		return pvz.GetPVZsRequest{}, err
+	}
 
-		page = parsedPage
+	nested, err := nestedFromParams(params)
+	if err != nil {
+		return pvz.GetPVZsRequest{}, err
 	}
 
-	limit := 10
+	req.Nested = nested
+
+	return req, nil
+}
 
-	if l := query.Get("limit"); l != "" {
-		parsedLimit, err := strconv.Atoi(l)
-		if err != nil || parsedLimit < 1 || parsedLimit > 30 {
-			respondWithError(w, http.StatusBadRequest, "неверный параметр limit", err, h.logger)
-			return
+// filtersFromParams переносит фильтры и сортировку в запрос. Поле сортировки по умолчанию выбирает сервис.
+func filtersFromParams(params dto.GetPVZsParams, req *pvz.GetPVZsRequest) error {
+	req.RegisteredFrom = params.RegisteredFrom
+	req.RegisteredTo = params.RegisteredTo
+	req.HasOpenReception = params.HasOpenReception
+
+	if params.City != nil {
+		for _, city := range *params.City {
+			c := pvz.City(city)
+			if !c.Validate() {
+				return &pvz.ErrInvalidCity{}
+			}
+
+			req.Cities = append(req.Cities, c)
 		}
+	}
+
+	if params.ReceptionStatus != nil {
+		for _, status := range *params.ReceptionStatus {
+			s := reception.Status(status)
+			if !s.Validate() {
+				return &pvz.ValidationError{Key: pvz.DetailInvalidReceptionStatus}
+			}
 
-		limit = parsedLimit
+			req.ReceptionStatuses = append(req.ReceptionStatuses, s)
+		}
 	}
 
-	requestedCity := query.Get("city")
+	if params.ProductType != nil {
+		for _, productType := range *params.ProductType {
+			t := product.Type(productType)
+			if !t.Validate() {
+				return &product.ErrInvalidProductType{}
+			}
 
-	req := pvz.GetPVZsRequest{
-		StartDate: startDate,
-		EndDate:   endDate,
-		Page:      page,
-		Limit:     limit,
+			req.ProductTypes = append(req.ProductTypes, t)
+		}
 	}
 
-	if requestedCity != "" {
-		c := pvz.City(requestedCity)
-		if !c.Validate() {
-			respondWithError(w, http.StatusBadRequest, "неверный параметр city", nil, h.logger)
-			return
+	if params.Sort != nil {
+		req.Sort.Field = pvz.SortField(*params.Sort)
+		if !req.Sort.Field.Validate() {
+			return &pvz.ValidationError{Key: pvz.DetailUnknownSortField}
 		}
+	}
 
-		req.City = &c
+	if params.Order != nil {
+		req.Sort.Asc = *params.Order == dto.OrderAsc
 	}
 
-	pvzList, err := h.service.GetPVZs(r.Context(), req)
-	if err != nil {
-		h.logger.Error("Ошибка при получении списка ПВЗ", "error", err)
-		respondWithError(w, http.StatusInternalServerError, "ошибка при получении списка ПВЗ", err, h.logger)
+	return nil
+}
+
+// nestedFromParams разбирает include и лимиты вложенных данных. Без include вкладываются и приемки, и товары,
+// include=none оставляет только ПВЗ; товары без приемок вложить нельзя.
+func nestedFromParams(params dto.GetPVZsParams) (pvz.Nested, error) {
+	var nested pvz.Nested
+
+	if params.Include != nil {
+		if slices.Contains(*params.Include, dto.IncludeNone) && len(*params.Include) > 1 {
+			return pvz.Nested{}, &pvz.ValidationError{Key: pvz.DetailIncludeNoneCombined}
+		}
 
-		return
+		nested.OmitReceptions = !slices.Contains(*params.Include, dto.IncludeReceptions)
+		nested.OmitProducts = !slices.Contains(*params.Include, dto.IncludeProducts)
+
+		if nested.OmitReceptions && !nested.OmitProducts {
+			return pvz.Nested{}, &pvz.ValidationError{Key: pvz.DetailProductsWithoutReceptions}
+		}
 	}
 
-	filteredList := pvzList
-	if requestedCity != "" {
-		filteredList = make([]pvz.WithReceptions, 0)
+	if params.ReceptionsLimit != nil {
+		if *params.ReceptionsLimit < 1 || *params.ReceptionsLimit > reception.MaxPageSize {
+			return pvz.Nested{}, &pvz.ErrInvalidPaginationParams{}
+		}
 
-		for _, item := range pvzList {
-			if string(item.PVZ.City) == requestedCity {
-				filteredList = append(filteredList, item)
-			}
+		nested.ReceptionsLimit = *params.ReceptionsLimit
+	}
+
+	if params.ProductsLimit != nil {
+		if *params.ProductsLimit < 1 || *params.ProductsLimit > product.MaxPageSize {
+			return pvz.Nested{}, &pvz.ErrInvalidPaginationParams{}
 		}
+
+		nested.ProductsLimit = *params.ProductsLimit
+	}
+
+	return nested, nil
+}
+
+// pvzPageQuery повторяет параметры запроса списка ПВЗ для ссылки на следующую страницу;
+// номер страницы не переносится, его заменяет курсор.
+func pvzPageQuery(params dto.GetPVZsParams) url.Values {
+	query := url.Values{}
+
+	if params.StartDate != nil {
+		query.Set("startDate", params.StartDate.Format(time.RFC3339Nano))
 	}
 
-	response := make([]map[string]interface{}, 0, len(filteredList))
+	if params.EndDate != nil {
+		query.Set("endDate", params.EndDate.Format(time.RFC3339Nano))
+	}
 
-	for _, p := range filteredList {
-		receptions := make([]map[string]interface{}, 0, len(p.Receptions))
+	if params.City != nil {
+		query.Set("city", joinValues(*params.City))
+	}
 
-		for _, r := range p.Receptions {
-			products := make([]dto.Product, 0, len(r.Products))
+	if params.ReceptionStatus != nil {
+		query.Set("receptionStatus", joinValues(*params.ReceptionStatus))
+	}
 
-			for _, pr := range r.Products {
-				prID, _ := uuid.Parse(pr.ID.String())
-				recID, _ := uuid.Parse(pr.ReceptionID.String())
+	if params.ProductType != nil {
+		query.Set("productType", joinValues(*params.ProductType))
+	}
 
-				var productType dto.ProductType
+	if params.HasOpenReception != nil {
+		query.Set("hasOpenReception", strconv.FormatBool(*params.HasOpenReception))
+	}
 
-				//nolint:exhaustive // Обрабатываем только 	известные типы товаров
-				switch pr.Type {
-				case "электроника":
-					productType = dto.ProductType("электроника")
-				case "одежда":
-					productType = dto.ProductType("одежда")
-				case "обувь":
-					productType = dto.ProductType("обувь")
-				}
+	if params.RegisteredFrom != nil {
+		query.Set("registeredFrom", params.RegisteredFrom.Format(time.RFC3339Nano))
+	}
 
-				products = append(products, dto.Product{
-					Id:          &prID,
-					DateTime:    &pr.DateTime,
-					Type:        productType,
-					ReceptionId: recID,
-				})
-			}
+	if params.RegisteredTo != nil {
+		query.Set("registeredTo", params.RegisteredTo.Format(time.RFC3339Nano))
+	}
 
-			recID, _ := uuid.Parse(r.Reception.ID.String())
-			pvzID, _ := uuid.Parse(r.Reception.PVZID.String())
+	if params.Sort != nil {
+		query.Set("sort", string(*params.Sort))
+	}
 
-			var status dto.ReceptionStatus
+	if params.Order != nil {
+		query.Set("order", string(*params.Order))
+	}
 
-			//nolint:exhaustive // Обрабатываем только статусы в прогрессе и закрытые
-			switch r.Reception.Status {
-			case "in_progress":
-				status = dto.InProgress
-			case "close":
-				status = dto.Close
-			}
+	if params.Limit != nil {
+		query.Set("limit", strconv.Itoa(*params.Limit))
+	}
 
-			recDTO := map[string]interface{}{
-				"reception": dto.Reception{
-					Id:       &recID,
-					DateTime: r.Reception.DateTime,
-					PvzId:    pvzID,
-					Status:   status,
-				},
-				"products": products,
-			}
+	if params.WithTotal != nil {
+		query.Set("withTotal", strconv.FormatBool(*params.WithTotal))
+	}
 
-			receptions = append(receptions, recDTO)
-		}
+	if params.Include != nil {
+		query.Set("include", joinValues(*params.Include))
+	}
+
+	if params.ReceptionsLimit != nil {
+		query.Set("receptionsLimit", strconv.Itoa(*params.ReceptionsLimit))
+	}
+
+	if params.ProductsLimit != nil {
+		query.Set("productsLimit", strconv.Itoa(*params.ProductsLimit))
+	}
+
+	return query
+}
+
+type pvzPageResponse struct {
+	body       []dto.PVZWithReceptions
+	nextCursor string
+	link       string
+	total      *int
+}
 
-		pvzID, _ := uuid.Parse(p.PVZ.ID.String())
+func (r pvzPageResponse) VisitGetPVZsResponse(w http.ResponseWriter) error {
+	return writePage(w, r.body, r.nextCursor, r.link, r.total)
+}
+
+func (h *PVZHandler) GetPVZByID(ctx context.Context, request dto.GetPVZByIDRequestObject) (dto.GetPVZByIDResponseObject, error) {
+	item, err := h.service.GetPVZByID(ctx, request.PvzId)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении ПВЗ: %w", err)
+	}
+
+	return dto.GetPVZByID200JSONResponse(pvzWithReceptionsToDTO(*item)), nil
+}
+
+func (h *PVZHandler) GetPVZsByIDs(ctx context.Context,
+	request dto.GetPVZsByIDsRequestObject) (dto.GetPVZsByIDsResponseObject, error) {
+	items, err := h.service.GetPVZsByIDs(ctx, request.Params.Ids)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении списка ПВЗ: %w", err)
+	}
+
+	response := make(dto.GetPVZsByIDs200JSONResponse, 0, len(items))
+	for _, item := range items {
+		response = append(response, pvzWithReceptionsToDTO(item))
+	}
+
+	return response, nil
+}
+
+func pvzWithReceptionsToDTO(p pvz.WithReceptions) dto.PVZWithReceptions {
+	receptions := make([]dto.ReceptionWithProducts, 0, len(p.Receptions))
 
-		var city dto.PVZCity
+	for _, r := range p.Receptions {
+		products := make([]dto.Product, 0, len(r.Products))
 
-		switch p.PVZ.City {
-		case pvz.CityMoscow:
-			city = dto.PVZCity("Москва")
-		case pvz.CitySaintPetersburg:
-			city = dto.PVZCity("Санкт-Петербург")
-		case pvz.CityKazan:
-			city = dto.PVZCity("Казань")
+		for _, pr := range r.Products {
+			products = append(products, productToDTO(pr))
 		}
 
-		pvzDTO := map[string]interface{}{
-			"pvz": dto.PVZ{
-				Id:               &pvzID,
-				RegistrationDate: &p.PVZ.RegistrationDate,
-				City:             city,
-			},
-			"receptions": receptions,
+		rec := receptionToDTO(r.Reception)
+		item := dto.ReceptionWithProducts{
+			Reception: &rec,
+			Products:  &products,
 		}
 
-		response = append(response, pvzDTO)
+		if r.HasMoreProducts {
+			item.HasMoreProducts = &r.HasMoreProducts
+		}
+
+		receptions = append(receptions, item)
+	}
+
+	pvzID, _ := uuid.Parse(p.PVZ.ID.String())
+
+	var city dto.PVZCity
+
+	switch p.PVZ.City {
+	case pvz.CityMoscow:
+		city = dto.PVZCity("Москва")
+	case pvz.CitySaintPetersburg:
+		city = dto.PVZCity("Санкт-Петербург")
+	case pvz.CityKazan:
+		city = dto.PVZCity("Казань")
+	}
+
+	result := dto.PVZWithReceptions{
+		Pvz: &dto.PVZ{
+			Id:               &pvzID,
+			RegistrationDate: &p.PVZ.RegistrationDate,
+			City:             city,
+		},
+		Receptions: &receptions,
+	}
+
+	if p.HasMoreReceptions {
+		result.HasMoreReceptions = &p.HasMoreReceptions
 	}
 
-	respondWithJSON(w, http.StatusOK, response)
+	return result
 }
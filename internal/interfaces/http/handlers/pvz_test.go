@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"slices"
 	"testing"
 	"time"
 
@@ -27,7 +29,7 @@ import (
 
 func TestPVZHandler_CreatePVZ(t *testing.T) {
 	type args struct {
-		request dto.PostPvzJSONRequestBody
+		request dto.CreatePVZJSONRequestBody
 	}
 
 	tests := []struct {
@@ -40,7 +42,7 @@ func TestPVZHandler_CreatePVZ(t *testing.T) {
 		{
 			name: "Успешное создание ПВЗ в Москве",
 			args: args{
-				request: dto.PostPvzJSONRequestBody{
+				request: dto.CreatePVZJSONRequestBody{
 					City: dto.PVZCity("Москва"),
 				},
 			},
@@ -67,7 +69,7 @@ func TestPVZHandler_CreatePVZ(t *testing.T) {
 		{
 			name: "Успешное создание ПВЗ в Санкт-Петербурге",
 			args: args{
-				request: dto.PostPvzJSONRequestBody{
+				request: dto.CreatePVZJSONRequestBody{
 					City: dto.PVZCity("Санкт-Петербург"),
 				},
 			},
@@ -94,7 +96,7 @@ func TestPVZHandler_CreatePVZ(t *testing.T) {
 		{
 			name: "Успешное создание ПВЗ в Казани",
 			args: args{
-				request: dto.PostPvzJSONRequestBody{
+				request: dto.CreatePVZJSONRequestBody{
 					City: dto.PVZCity("Казань"),
 				},
 			},
@@ -121,7 +123,7 @@ func TestPVZHandler_CreatePVZ(t *testing.T) {
 		{
 			name: "Неверный город",
 			args: args{
-				request: dto.PostPvzJSONRequestBody{
+				request: dto.CreatePVZJSONRequestBody{
 					City: dto.PVZCity("Новосибирск"),
 				},
 			},
@@ -129,10 +131,25 @@ func TestPVZHandler_CreatePVZ(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedBody:   nil,
 		},
+		{
+			name: "Ошибка валидации в сервисе",
+			args: args{
+				request: dto.CreatePVZJSONRequestBody{
+					City: dto.PVZCity("Москва"),
+				},
+			},
+			setupMock: func(mockSvc *mocks.PVZService) {
+				mockSvc.On("CreatePVZ", mock.Anything, pvz.CreatePVZRequest{
+					City: pvz.CityMoscow,
+				}).Return(nil, &pvz.ValidationError{Key: pvz.DetailEmptyIDs})
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   nil,
+		},
 		{
 			name: "Ошибка сервиса при создании ПВЗ",
 			args: args{
-				request: dto.PostPvzJSONRequestBody{
+				request: dto.CreatePVZJSONRequestBody{
 					City: dto.PVZCity("Москва"),
 				},
 			},
@@ -141,13 +158,13 @@ func TestPVZHandler_CreatePVZ(t *testing.T) {
 					City: pvz.CityMoscow,
 				}).Return(nil, fmt.Errorf("ошибка при создании ПВЗ"))
 			},
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   nil,
 		},
 		{
 			name: "Некорректный JSON в запросе",
 			args: args{
-				request: dto.PostPvzJSONRequestBody{},
+				request: dto.CreatePVZJSONRequestBody{},
 			},
 			setupMock:      func(mockSvc *mocks.PVZService) {},
 			expectedStatus: http.StatusBadRequest,
@@ -161,7 +178,7 @@ func TestPVZHandler_CreatePVZ(t *testing.T) {
 			tt.setupMock(mockService)
 
 			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
-			handler := handlers.NewPVZHandler(mockService, nullLogger)
+			handler := newTestHandler(handlers.NewServer(nil, handlers.NewPVZHandler(mockService), nil, nil), nullLogger)
 
 			var requestBody []byte
 
@@ -180,7 +197,7 @@ func TestPVZHandler_CreatePVZ(t *testing.T) {
 
 			recorder := httptest.NewRecorder()
 
-			handler.CreatePVZ(recorder, req)
+			handler.ServeHTTP(recorder, req)
 
 			assert.Equal(t, tt.expectedStatus, recorder.Code)
 
@@ -213,13 +230,22 @@ func TestPVZHandler_GetPVZs(t *testing.T) {
 	yesterday := now.Add(-24 * time.Hour)
 	tomorrow := now.Add(24 * time.Hour)
 
+	cursor := pvz.Cursor{Time: time.Date(2025, 4, 1, 10, 0, 0, 123456000, time.UTC), ID: pvzID1}
+	nextLink := url.Values{
+		"city":      {"Москва"},
+		"cursor":    {cursor.Encode()},
+		"limit":     {"1"},
+		"withTotal": {"true"},
+	}
+
 	// Создаем тестовые кейсы
 	tests := []struct {
-		name           string
-		queryParams    map[string]string
-		setupMock      func(mockSvc *mocks.PVZService)
-		expectedStatus int
-		expectedPVZs   int
+		name            string
+		queryParams     map[string]string
+		setupMock       func(mockSvc *mocks.PVZService)
+		expectedStatus  int
+		expectedPVZs    int
+		expectedHeaders map[string]string
 	}{
 		{
 			name:        "Успешное получение списка ПВЗ без фильтров",
@@ -264,7 +290,7 @@ func TestPVZHandler_GetPVZs(t *testing.T) {
 				mockSvc.On("GetPVZs", mock.Anything, pvz.GetPVZsRequest{
 					Page:  1,
 					Limit: 10,
-				}).Return(pvzs, nil)
+				}).Return(&pvz.Page{Items: pvzs}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedPVZs:   2,
@@ -289,10 +315,10 @@ func TestPVZHandler_GetPVZs(t *testing.T) {
 				}
 
 				mockSvc.On("GetPVZs", mock.Anything, pvz.GetPVZsRequest{
-					City:  &city,
-					Page:  1,
-					Limit: 10,
-				}).Return(pvzs, nil)
+					Cities: []pvz.City{city},
+					Page:   1,
+					Limit:  10,
+				}).Return(&pvz.Page{Items: pvzs}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedPVZs:   1,
@@ -307,7 +333,7 @@ func TestPVZHandler_GetPVZs(t *testing.T) {
 				mockSvc.On("GetPVZs", mock.Anything, mock.MatchedBy(func(req pvz.GetPVZsRequest) bool {
 					return req.Page == 1 && req.Limit == 10 &&
 						req.StartDate != nil && req.EndDate != nil
-				})).Return([]pvz.WithReceptions{
+				})).Return(&pvz.Page{Items: []pvz.WithReceptions{
 					{
 						PVZ: pvz.PVZ{
 							ID:               pvzID1,
@@ -324,7 +350,7 @@ func TestPVZHandler_GetPVZs(t *testing.T) {
 						},
 						Receptions: []pvz.ReceptionWithItems{},
 					},
-				}, nil)
+				}}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedPVZs:   2,
@@ -350,11 +376,177 @@ func TestPVZHandler_GetPVZs(t *testing.T) {
 				mockSvc.On("GetPVZs", mock.Anything, pvz.GetPVZsRequest{
 					Page:  2,
 					Limit: 5,
-				}).Return(pvzs, nil)
+				}).Return(&pvz.Page{Items: pvzs}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedPVZs:   1,
 		},
+		{
+			name: "Страница с курсором следующей страницы и общим количеством",
+			queryParams: map[string]string{
+				"city":      "Москва",
+				"limit":     "1",
+				"withTotal": "true",
+			},
+			setupMock: func(mockSvc *mocks.PVZService) {
+				city := pvz.CityMoscow
+				total := 7
+
+				mockSvc.On("GetPVZs", mock.Anything, pvz.GetPVZsRequest{
+					Cities:    []pvz.City{city},
+					Page:      1,
+					Limit:     1,
+					WithTotal: true,
+				}).Return(&pvz.Page{
+					Items: []pvz.WithReceptions{{PVZ: pvz.PVZ{ID: pvzID1, RegistrationDate: now, City: city}}},
+					Next:  &cursor,
+					Total: &total,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedPVZs:   1,
+			expectedHeaders: map[string]string{
+				"X-Next-Cursor": cursor.Encode(),
+				"X-Total-Count": "7",
+				"Link":          "</pvz?" + nextLink.Encode() + `>; rel="next"`,
+			},
+		},
+		{
+			name: "Запрос страницы по курсору",
+			queryParams: map[string]string{
+				"cursor": cursor.Encode(),
+			},
+			setupMock: func(mockSvc *mocks.PVZService) {
+				mockSvc.On("GetPVZs", mock.Anything, mock.MatchedBy(func(req pvz.GetPVZsRequest) bool {
+					return req.After != nil && req.After.ID == cursor.ID &&
+						req.After.Time.Equal(cursor.Time) && req.Limit == 10
+				})).Return(&pvz.Page{Items: []pvz.WithReceptions{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedPVZs:   0,
+		},
+		{
+			name: "Некорректный курсор",
+			queryParams: map[string]string{
+				"cursor": "не-курсор",
+			},
+			setupMock:      func(mockSvc *mocks.PVZService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedPVZs:   0,
+		},
+		{
+			name: "Несколько значений фильтров и сортировка",
+			queryParams: map[string]string{
+				"city":             "Москва,Казань",
+				"receptionStatus":  "in_progress",
+				"productType":      "обувь,одежда",
+				"hasOpenReception": "true",
+				"registeredFrom":   yesterday.Format(time.RFC3339),
+				"sort":             "lastActivity",
+				"order":            "asc",
+			},
+			setupMock: func(mockSvc *mocks.PVZService) {
+				mockSvc.On("GetPVZs", mock.Anything, mock.MatchedBy(func(req pvz.GetPVZsRequest) bool {
+					return slices.Equal(req.Cities, []pvz.City{pvz.CityMoscow, pvz.CityKazan}) &&
+						slices.Equal(req.ReceptionStatuses, []reception.Status{reception.StatusInProgress}) &&
+						slices.Equal(req.ProductTypes, []product.Type{product.TypeShoes, product.TypeClothes}) &&
+						req.HasOpenReception != nil && *req.HasOpenReception &&
+						req.RegisteredFrom != nil && req.RegisteredTo == nil &&
+						req.Sort == pvz.Sort{Field: pvz.SortLastActivity, Asc: true}
+				})).Return(&pvz.Page{Items: []pvz.WithReceptions{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedPVZs:   0,
+		},
+		{
+			name: "Неизвестное поле сортировки",
+			queryParams: map[string]string{
+				"sort": "city",
+			},
+			setupMock:      func(mockSvc *mocks.PVZService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedPVZs:   0,
+		},
+		{
+			name: "Ограничение вложенных приемок и товаров",
+			queryParams: map[string]string{
+				"receptionsLimit": "1",
+				"productsLimit":   "2",
+			},
+			setupMock: func(mockSvc *mocks.PVZService) {
+				mockSvc.On("GetPVZs", mock.Anything, pvz.GetPVZsRequest{
+					Page:   1,
+					Limit:  10,
+					Nested: pvz.Nested{ReceptionsLimit: 1, ProductsLimit: 2},
+				}).Return(&pvz.Page{Items: []pvz.WithReceptions{{
+					PVZ: pvz.PVZ{ID: pvzID1, RegistrationDate: now, City: pvz.CityMoscow},
+					Receptions: []pvz.ReceptionWithItems{{
+						Reception:       reception.Reception{ID: receptionID1, DateTime: now, PVZID: pvzID1},
+						HasMoreProducts: true,
+					}},
+					HasMoreReceptions: true,
+				}}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedPVZs:   1,
+		},
+		{
+			name: "Только ПВЗ и приемки без товаров",
+			queryParams: map[string]string{
+				"include": "receptions",
+			},
+			setupMock: func(mockSvc *mocks.PVZService) {
+				mockSvc.On("GetPVZs", mock.Anything, pvz.GetPVZsRequest{
+					Page:   1,
+					Limit:  10,
+					Nested: pvz.Nested{OmitProducts: true},
+				}).Return(&pvz.Page{Items: []pvz.WithReceptions{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedPVZs:   0,
+		},
+		{
+			name: "Только ПВЗ без вложенных данных",
+			queryParams: map[string]string{
+				"include": "none",
+			},
+			setupMock: func(mockSvc *mocks.PVZService) {
+				mockSvc.On("GetPVZs", mock.Anything, pvz.GetPVZsRequest{
+					Page:   1,
+					Limit:  10,
+					Nested: pvz.Nested{OmitReceptions: true, OmitProducts: true},
+				}).Return(&pvz.Page{Items: []pvz.WithReceptions{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedPVZs:   0,
+		},
+		{
+			name: "none вместе с приемками",
+			queryParams: map[string]string{
+				"include": "none,receptions",
+			},
+			setupMock:      func(mockSvc *mocks.PVZService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedPVZs:   0,
+		},
+		{
+			name: "Товары без приемок",
+			queryParams: map[string]string{
+				"include": "products",
+			},
+			setupMock:      func(mockSvc *mocks.PVZService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedPVZs:   0,
+		},
+		{
+			name: "Лимит вложенных приемок больше максимального",
+			queryParams: map[string]string{
+				"receptionsLimit": "101",
+			},
+			setupMock:      func(mockSvc *mocks.PVZService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedPVZs:   0,
+		},
 		{
 			name: "Некорректный параметр page",
 			queryParams: map[string]string{
@@ -423,7 +615,7 @@ func TestPVZHandler_GetPVZs(t *testing.T) {
 
 			// Создаем обработчик с моком
 			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
-			handler := handlers.NewPVZHandler(mockService, nullLogger)
+			handler := newTestHandler(handlers.NewServer(nil, handlers.NewPVZHandler(mockService), nil, nil), nullLogger)
 
 			// Подготавливаем запрос с параметрами
 			req, err := http.NewRequest(http.MethodGet, "/pvz", http.NoBody)
@@ -440,13 +632,17 @@ func TestPVZHandler_GetPVZs(t *testing.T) {
 			recorder := httptest.NewRecorder()
 
 			// Вызываем обработчик
-			handler.GetPVZs(recorder, req)
+			handler.ServeHTTP(recorder, req)
 
 			// Проверяем статус ответа
 			assert.Equal(t, tt.expectedStatus, recorder.Code)
 
-			// Если ожидается успешный ответ, проверяем тело
+			// Если ожидается успешный ответ, проверяем заголовки пагинации и тело
 			if tt.expectedStatus == http.StatusOK {
+				for _, header := range []string{"X-Next-Cursor", "X-Total-Count", "Link"} {
+					assert.Equal(t, tt.expectedHeaders[header], recorder.Header().Get(header), header)
+				}
+
 				var responseBody []map[string]interface{}
 				err = json.Unmarshal(recorder.Body.Bytes(), &responseBody)
 				require.NoError(t, err)
@@ -475,3 +671,162 @@ func TestPVZHandler_GetPVZs(t *testing.T) {
 		})
 	}
 }
+
+func TestPVZHandler_GetPVZByID(t *testing.T) {
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		url            string
+		setupMock      func(mockSvc *mocks.PVZService)
+		expectedStatus int
+	}{
+		{
+			name: "Успешное получение ПВЗ",
+			url:  "/pvz/" + pvzID.String(),
+			setupMock: func(mockSvc *mocks.PVZService) {
+				mockSvc.On("GetPVZByID", mock.Anything, pvzID).Return(&pvz.WithReceptions{
+					PVZ: pvz.PVZ{
+						ID:               pvzID,
+						RegistrationDate: now,
+						City:             pvz.CityKazan,
+					},
+					Receptions: []pvz.ReceptionWithItems{
+						{
+							Reception: reception.Reception{
+								ID:       receptionID,
+								DateTime: now,
+								PVZID:    pvzID,
+								Status:   reception.StatusClosed,
+							},
+							Products: []product.Product{},
+						},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "ПВЗ не найден",
+			url:  "/pvz/" + pvzID.String(),
+			setupMock: func(mockSvc *mocks.PVZService) {
+				mockSvc.On("GetPVZByID", mock.Anything, pvzID).Return(nil, &pvz.ErrPVZNotFound{})
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Неверный UUID",
+			url:            "/pvz/invalid-uuid",
+			setupMock:      func(mockSvc *mocks.PVZService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.PVZService)
+			tt.setupMock(mockService)
+
+			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
+			handler := newTestHandler(handlers.NewServer(nil, handlers.NewPVZHandler(mockService), nil, nil), nullLogger)
+
+			req, err := http.NewRequest(http.MethodGet, tt.url, http.NoBody)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var responseBody dto.PVZWithReceptions
+				err = json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+				require.NoError(t, err)
+
+				require.NotNil(t, responseBody.Pvz)
+				assert.Equal(t, pvzID, *responseBody.Pvz.Id)
+				require.NotNil(t, responseBody.Receptions)
+				require.Len(t, *responseBody.Receptions, 1)
+				assert.Equal(t, dto.Close, (*responseBody.Receptions)[0].Reception.Status)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPVZHandler_GetPVZsByIDs(t *testing.T) {
+	firstID := uuid.New()
+	secondID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ids            string
+		setupMock      func(mockSvc *mocks.PVZService)
+		expectedStatus int
+		expectedPVZs   int
+	}{
+		{
+			name: "Успешное получение нескольких ПВЗ",
+			ids:  firstID.String() + "," + secondID.String(),
+			setupMock: func(mockSvc *mocks.PVZService) {
+				mockSvc.On("GetPVZsByIDs", mock.Anything, []uuid.UUID{firstID, secondID}).Return([]pvz.WithReceptions{
+					{PVZ: pvz.PVZ{ID: firstID, City: pvz.CityMoscow}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedPVZs:   1,
+		},
+		{
+			name:           "Пустой параметр ids",
+			ids:            "",
+			setupMock:      func(mockSvc *mocks.PVZService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Неверный UUID в списке",
+			ids:            firstID.String() + ",invalid",
+			setupMock:      func(mockSvc *mocks.PVZService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.PVZService)
+			tt.setupMock(mockService)
+
+			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
+			handler := newTestHandler(handlers.NewServer(nil, handlers.NewPVZHandler(mockService), nil, nil), nullLogger)
+
+			req, err := http.NewRequest(http.MethodGet, "/pvz/batch", http.NoBody)
+			require.NoError(t, err)
+
+			q := req.URL.Query()
+			if tt.ids != "" {
+				q.Add("ids", tt.ids)
+			}
+
+			req.URL.RawQuery = q.Encode()
+
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var responseBody []dto.PVZWithReceptions
+				err = json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+				require.NoError(t, err)
+
+				assert.Len(t, responseBody, tt.expectedPVZs)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
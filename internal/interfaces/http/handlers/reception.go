@@ -2,70 +2,40 @@ package handlers
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
+	"fmt"
 	"net/http"
-	"strings"
+	"net/url"
+	"strconv"
 
+	"avito/internal/domain/pvz"
 	"avito/internal/domain/reception"
 	"avito/internal/interfaces/http/dto"
 	"avito/internal/metrics"
 
-	"log/slog"
-
 	"github.com/google/uuid"
 )
 
-var (
-	ErrActiveReceptionExists = errors.New("уже есть незакрытая приемка")
-	ErrNoActiveReception     = errors.New("нет открытых приемок")
-)
-
 type ReceptionService interface {
 	CreateReception(ctx context.Context, pvzID uuid.UUID) (*reception.Reception, error)
 	CloseLastReception(ctx context.Context, pvzID uuid.UUID) (*reception.Reception, error)
+	ListReceptions(ctx context.Context, req reception.ListRequest) (*reception.Page, error)
 }
 
 type ReceptionHandler struct {
 	service ReceptionService
-	logger  *slog.Logger
 }
 
-func NewReceptionHandler(service ReceptionService, logger *slog.Logger) *ReceptionHandler {
+func NewReceptionHandler(service ReceptionService) *ReceptionHandler {
 	return &ReceptionHandler{
 		service: service,
-		logger:  logger,
 	}
 }
 
-func (h *ReceptionHandler) CreateReception(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		respondWithError(w, http.StatusMethodNotAllowed, "метод не поддерживается", nil, h.logger)
-		return
-	}
-
-	var req dto.PostReceptionsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondWithError(w, http.StatusBadRequest, "неверный формат запроса", err, h.logger)
-		return
-	}
-
-	pvzID, err := uuid.Parse(req.PvzId.String())
-	if err != nil {
-		respondWithError(w, http.StatusBadRequest, "неверный формат UUID", err, h.logger)
-		return
-	}
-
-	rec, err := h.service.CreateReception(r.Context(), pvzID)
+func (h *ReceptionHandler) CreateReception(ctx context.Context,
+	request dto.CreateReceptionRequestObject) (dto.CreateReceptionResponseObject, error) {
+	rec, err := h.service.CreateReception(ctx, request.Body.PvzId)
 	if err != nil {
-		switch {
-		case errors.Is(err, ErrActiveReceptionExists):
-			respondWithError(w, http.StatusBadRequest, "уже есть незакрытая приемка", err, h.logger)
-		default:
-			respondWithError(w, http.StatusInternalServerError, "ошибка при создании приемки", err, h.logger)
-		}
-
-		return
+		return nil, fmt.Errorf("ошибка при создании приемки: %w", err)
 	}
 
 	metrics.ReceptionsCreatedTotal.Inc()
@@ -73,65 +43,109 @@ func (h *ReceptionHandler) CreateReception(w http.ResponseWriter, r *http.Reques
 	recID, _ := uuid.Parse(rec.ID.String())
 	pvzIDParsed, _ := uuid.Parse(rec.PVZID.String())
 
-	response := dto.Reception{
+	return dto.CreateReception201JSONResponse{
 		Id:       &recID,
 		DateTime: rec.DateTime,
 		PvzId:    pvzIDParsed,
 		Status:   dto.InProgress,
+	}, nil
+}
+
+func (h *ReceptionHandler) CloseLastReception(ctx context.Context,
+	request dto.CloseLastReceptionRequestObject) (dto.CloseLastReceptionResponseObject, error) {
+	rec, err := h.service.CloseLastReception(ctx, request.PvzId)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при закрытии приемки: %w", err)
 	}
 
-	respondWithJSON(w, http.StatusCreated, response)
+	recID, _ := uuid.Parse(rec.ID.String())
+	pvzIDParsed, _ := uuid.Parse(rec.PVZID.String())
+
+	return dto.CloseLastReception200JSONResponse{
+		Id:       &recID,
+		DateTime: rec.DateTime,
+		PvzId:    pvzIDParsed,
+		Status:   dto.Close,
+	}, nil
 }
 
-func (h *ReceptionHandler) CloseLastReception(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		respondWithError(w, http.StatusMethodNotAllowed, "метод не поддерживается", nil, h.logger)
-		return
+// GetPVZReceptions возвращает приемки ПВЗ от новых к старым с пагинацией курсором.
+func (h *ReceptionHandler) GetPVZReceptions(ctx context.Context,
+	request dto.GetPVZReceptionsRequestObject) (dto.GetPVZReceptionsResponseObject, error) {
+	req := reception.ListRequest{
+		PVZID: request.PvzId,
+		Limit: reception.DefaultPageSize,
 	}
 
-	path := r.URL.Path
-	h.logger.Info("Получен запрос на закрытие приемки", "path", path)
-
-	trimmedPath := strings.Trim(path, "/")
-	parts := strings.Split(trimmedPath, "/")
+	query := url.Values{}
 
-	h.logger.Info("Разбор пути", "parts", parts, "count", len(parts))
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 || *request.Params.Limit > reception.MaxPageSize {
+			return nil, &pvz.ErrInvalidPaginationParams{}
+		}
 
-	if len(parts) != 3 || parts[0] != "pvz" || parts[2] != "close_last_reception" {
-		respondWithError(w, http.StatusBadRequest, "неверный URL", nil, h.logger)
-		return
+		req.Limit = *request.Params.Limit
+		query.Set("limit", strconv.Itoa(req.Limit))
 	}
 
-	pvzIDStr := parts[1]
-	h.logger.Info("Извлечен ID PVZ", "pvzID", pvzIDStr)
+	if request.Params.Cursor != nil {
+		cursor, err := reception.DecodeCursor(*request.Params.Cursor)
+		if err != nil {
+			return nil, err
+		}
 
-	pvzID, err := uuid.Parse(pvzIDStr)
-	if err != nil {
-		respondWithError(w, http.StatusBadRequest, "неверный формат UUID", err, h.logger)
-		return
+		req.After = cursor
 	}
 
-	rec, err := h.service.CloseLastReception(r.Context(), pvzID)
+	page, err := h.service.ListReceptions(ctx, req)
 	if err != nil {
-		switch {
-		case errors.Is(err, ErrNoActiveReception):
-			respondWithError(w, http.StatusBadRequest, "нет открытых приемок", err, h.logger)
-		default:
-			respondWithError(w, http.StatusInternalServerError, "ошибка при закрытии приемки", err, h.logger)
-		}
+		return nil, fmt.Errorf("ошибка при получении приемок ПВЗ: %w", err)
+	}
 
-		return
+	response := receptionPageResponse{
+		body: make([]dto.Reception, 0, len(page.Items)),
 	}
 
-	recID, _ := uuid.Parse(rec.ID.String())
-	pvzIDParsed, _ := uuid.Parse(rec.PVZID.String())
+	for _, rec := range page.Items {
+		response.body = append(response.body, receptionToDTO(rec))
+	}
 
-	response := dto.Reception{
-		Id:       &recID,
-		DateTime: rec.DateTime,
-		PvzId:    pvzIDParsed,
-		Status:   dto.Close,
+	if page.Next != nil {
+		response.nextCursor = page.Next.Encode()
+		response.link = nextLink(fmt.Sprintf("/pvz/%s/receptions", request.PvzId), query, response.nextCursor)
 	}
 
-	respondWithJSON(w, http.StatusOK, response)
+	return response, nil
+}
+
+type receptionPageResponse struct {
+	body       []dto.Reception
+	nextCursor string
+	link       string
+}
+
+func (r receptionPageResponse) VisitGetPVZReceptionsResponse(w http.ResponseWriter) error {
+	return writePage(w, r.body, r.nextCursor, r.link, nil)
+}
+
+func receptionToDTO(r reception.Reception) dto.Reception {
+	recID, _ := uuid.Parse(r.ID.String())
+	pvzID, _ := uuid.Parse(r.PVZID.String())
+
+	var status dto.ReceptionStatus
+
+	//nolint:exhaustive // Обрабатываем только статусы в прогрессе и закрытые
+	switch r.Status {
+	case "in_progress":
+		status = dto.InProgress
+	case "close":
+		status = dto.Close
+	}
+
+	return dto.Reception{
+		Id:       &recID,
+		DateTime: r.DateTime,
+		PvzId:    pvzID,
+		Status:   status,
+	}
 }
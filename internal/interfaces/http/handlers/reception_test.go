@@ -9,6 +9,9 @@ import (
 	"testing"
 	"time"
 
+	"avito/internal/domain/cursor"
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/pvz"
 	"avito/internal/domain/reception"
 	"avito/internal/interfaces/http/dto"
 	"avito/internal/interfaces/http/handlers"
@@ -24,7 +27,7 @@ import (
 
 func TestReceptionHandler_CreateReception(t *testing.T) {
 	type args struct {
-		request dto.PostReceptionsJSONRequestBody
+		request dto.CreateReceptionJSONRequestBody
 	}
 
 	tests := []struct {
@@ -32,12 +35,13 @@ func TestReceptionHandler_CreateReception(t *testing.T) {
 		args           args
 		setupMock      func(mockSvc *mocks.ReceptionService)
 		expectedStatus int
+		expectedCode   errcode.Code
 		expectedBody   func() *reception.Reception
 	}{
 		{
 			name: "Успешное создание приемки",
 			args: args{
-				request: dto.PostReceptionsJSONRequestBody{
+				request: dto.CreateReceptionJSONRequestBody{
 					PvzId: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
 				},
 			},
@@ -65,15 +69,16 @@ func TestReceptionHandler_CreateReception(t *testing.T) {
 		{
 			name: "Уже есть активная приемка",
 			args: args{
-				request: dto.PostReceptionsJSONRequestBody{
+				request: dto.CreateReceptionJSONRequestBody{
 					PvzId: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
 				},
 			},
 			setupMock: func(mockSvc *mocks.ReceptionService) {
 				mockSvc.On("CreateReception", mock.Anything, uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")).
-					Return(nil, handlers.ErrActiveReceptionExists)
+					Return(nil, &reception.ErrActiveReceptionExists{})
 			},
 			expectedStatus: http.StatusBadRequest,
+			expectedCode:   reception.CodeReceptionAlreadyOpen,
 			expectedBody:   nil,
 		},
 	}
@@ -84,7 +89,7 @@ func TestReceptionHandler_CreateReception(t *testing.T) {
 			tt.setupMock(mockService)
 
 			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
-			handler := handlers.NewReceptionHandler(mockService, nullLogger)
+			handler := newTestHandler(handlers.NewServer(nil, nil, handlers.NewReceptionHandler(mockService), nil), nullLogger)
 
 			requestBody, err := json.Marshal(tt.args.request)
 			require.NoError(t, err)
@@ -95,7 +100,7 @@ func TestReceptionHandler_CreateReception(t *testing.T) {
 
 			recorder := httptest.NewRecorder()
 
-			handler.CreateReception(recorder, req)
+			handler.ServeHTTP(recorder, req)
 
 			assert.Equal(t, tt.expectedStatus, recorder.Code)
 
@@ -111,6 +116,13 @@ func TestReceptionHandler_CreateReception(t *testing.T) {
 				assert.Equal(t, dto.InProgress, responseBody.Status)
 			}
 
+			if tt.expectedCode != "" {
+				var problem dto.Error
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+				assert.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+				assert.Equal(t, string(tt.expectedCode), problem.Code)
+			}
+
 			mockService.AssertExpectations(t)
 		})
 	}
@@ -147,13 +159,13 @@ func TestReceptionHandler_CloseLastReception(t *testing.T) {
 			url:  "/pvz/" + pvzID.String() + "/close_last_reception",
 			setupMock: func(mockSvc *mocks.ReceptionService) {
 				mockSvc.On("CloseLastReception", mock.Anything, pvzID).
-					Return(nil, handlers.ErrNoActiveReception)
+					Return(nil, &reception.ErrNoActiveReception{})
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
-			name: "Неверный URL",
-			url:  "/invalid/url",
+			name: "Неверный UUID",
+			url:  "/pvz/invalid-uuid/close_last_reception",
 			setupMock: func(mockSvc *mocks.ReceptionService) {
 				// Метод не должен вызываться
 			},
@@ -167,17 +179,112 @@ func TestReceptionHandler_CloseLastReception(t *testing.T) {
 			tt.setupMock(mockService)
 
 			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
-			handler := handlers.NewReceptionHandler(mockService, nullLogger)
+			handler := newTestHandler(handlers.NewServer(nil, nil, handlers.NewReceptionHandler(mockService), nil), nullLogger)
 
 			req, err := http.NewRequest(http.MethodPost, tt.url, http.NoBody)
 			require.NoError(t, err)
 
 			recorder := httptest.NewRecorder()
 
-			handler.CloseLastReception(recorder, req)
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReceptionHandler_GetPVZReceptions(t *testing.T) {
+	pvzID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	recID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	now := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	next := reception.Cursor{DateTime: now, ID: recID}
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(mockSvc *mocks.ReceptionService)
+		expectedStatus int
+		expectedCode   errcode.Code
+		expectedItems  int
+		expectedLink   string
+	}{
+		{
+			name:  "Страница с курсором следующей страницы",
+			query: "?limit=1",
+			setupMock: func(mockSvc *mocks.ReceptionService) {
+				mockSvc.On("ListReceptions", mock.Anything, reception.ListRequest{PVZID: pvzID, Limit: 1}).
+					Return(&reception.Page{
+						Items: []reception.Reception{{ID: recID, DateTime: now, PVZID: pvzID, Status: reception.StatusClosed}},
+						Next:  &next,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedItems:  1,
+			expectedLink:   "</pvz/" + pvzID.String() + "/receptions?cursor=" + next.Encode() + `&limit=1>; rel="next"`,
+		},
+		{
+			name:  "Последняя страница по курсору",
+			query: "?cursor=" + next.Encode(),
+			setupMock: func(mockSvc *mocks.ReceptionService) {
+				mockSvc.On("ListReceptions", mock.Anything, mock.MatchedBy(func(req reception.ListRequest) bool {
+					return req.PVZID == pvzID && req.Limit == reception.DefaultPageSize &&
+						req.After != nil && req.After.ID == recID && req.After.DateTime.Equal(now)
+				})).Return(&reception.Page{Items: []reception.Reception{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedItems:  0,
+		},
+		{
+			name:           "Некорректный курсор",
+			query:          "?cursor=не-курсор",
+			setupMock:      func(mockSvc *mocks.ReceptionService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   cursor.CodeInvalid,
+		},
+		{
+			name:  "ПВЗ не найден",
+			query: "",
+			setupMock: func(mockSvc *mocks.ReceptionService) {
+				mockSvc.On("ListReceptions", mock.Anything, reception.ListRequest{PVZID: pvzID, Limit: reception.DefaultPageSize}).
+					Return(nil, &pvz.ErrPVZNotFound{})
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   pvz.CodePVZNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.ReceptionService)
+			tt.setupMock(mockService)
+
+			nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
+			handler := newTestHandler(handlers.NewServer(nil, nil, handlers.NewReceptionHandler(mockService), nil), nullLogger)
+
+			req, err := http.NewRequest(http.MethodGet, "/pvz/"+pvzID.String()+"/receptions"+tt.query, http.NoBody)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, req)
 
 			assert.Equal(t, tt.expectedStatus, recorder.Code)
 
+			if tt.expectedStatus == http.StatusOK {
+				var body []dto.Reception
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+				assert.Len(t, body, tt.expectedItems)
+				assert.Equal(t, tt.expectedLink, recorder.Header().Get("Link"))
+			}
+
+			if tt.expectedCode != "" {
+				var problem dto.Error
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+				assert.Equal(t, string(tt.expectedCode), problem.Code)
+			}
+
 			mockService.AssertExpectations(t)
 		})
 	}
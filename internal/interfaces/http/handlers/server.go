@@ -0,0 +1,27 @@
+package handlers
+
+import "avito/internal/interfaces/http/dto"
+
+// Server объединяет обработчики в реализацию серверного интерфейса, сгенерированного из спецификации.
+type Server struct {
+	*AuthHandler
+	*PVZHandler
+	*ReceptionHandler
+	*ProductHandler
+}
+
+var _ dto.StrictServerInterface = (*Server)(nil)
+
+func NewServer(
+	authHandler *AuthHandler,
+	pvzHandler *PVZHandler,
+	receptionHandler *ReceptionHandler,
+	productHandler *ProductHandler,
+) *Server {
+	return &Server{
+		AuthHandler:      authHandler,
+		PVZHandler:       pvzHandler,
+		ReceptionHandler: receptionHandler,
+		ProductHandler:   productHandler,
+	}
+}
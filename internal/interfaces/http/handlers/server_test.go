@@ -0,0 +1,108 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"avito/internal/domain/errcode"
+	"avito/internal/domain/reception"
+	"avito/internal/interfaces/http/dto"
+	"avito/internal/interfaces/http/handlers"
+	"avito/internal/interfaces/http/requestid"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestHandler подключает обработчики к сгенерированному роутингу так же, как это делает роутер приложения.
+func newTestHandler(server *handlers.Server, logger *slog.Logger) http.Handler {
+	strictHandler := dto.NewStrictHandlerWithOptions(server, nil, dto.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  handlers.RequestErrorHandler(logger),
+		ResponseErrorHandlerFunc: handlers.ResponseErrorHandler(logger),
+	})
+
+	return dto.HandlerWithOptions(strictHandler, dto.StdHTTPServerOptions{
+		ErrorHandlerFunc: handlers.ParamErrorHandler(logger),
+	})
+}
+
+func TestErrorHandlers(t *testing.T) {
+	nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
+
+	tests := []struct {
+		name            string
+		handle          func(w http.ResponseWriter, r *http.Request, err error)
+		err             error
+		expectedStatus  int
+		expectedCode    errcode.Code
+		expectedMessage string
+	}{
+		{
+			name:            "Ошибка разбора тела запроса",
+			handle:          handlers.RequestErrorHandler(nullLogger),
+			err:             errors.New("can't decode JSON body"),
+			expectedStatus:  http.StatusBadRequest,
+			expectedCode:    errcode.InvalidRequest,
+			expectedMessage: "неверный формат запроса",
+		},
+		{
+			name:            "Не передан обязательный параметр",
+			handle:          handlers.ParamErrorHandler(nullLogger),
+			err:             &dto.RequiredParamError{ParamName: "ids"},
+			expectedStatus:  http.StatusBadRequest,
+			expectedCode:    errcode.InvalidRequest,
+			expectedMessage: "не передан параметр ids",
+		},
+		{
+			name:            "Неверный формат параметра",
+			handle:          handlers.ParamErrorHandler(nullLogger),
+			err:             &dto.InvalidParamFormatError{ParamName: "pvzId", Err: errors.New("invalid UUID")},
+			expectedStatus:  http.StatusBadRequest,
+			expectedCode:    errcode.InvalidRequest,
+			expectedMessage: "неверный формат параметра pvzId",
+		},
+		{
+			name:            "Доменная ошибка из обработчика",
+			handle:          handlers.ResponseErrorHandler(nullLogger),
+			err:             fmt.Errorf("ошибка при создании приемки: %w", &reception.ErrActiveReceptionExists{}),
+			expectedStatus:  http.StatusBadRequest,
+			expectedCode:    reception.CodeReceptionAlreadyOpen,
+			expectedMessage: "уже есть незакрытая приемка",
+		},
+		{
+			name:            "Необработанная ошибка обработчика",
+			handle:          handlers.ResponseErrorHandler(nullLogger),
+			err:             errors.New("connection refused"),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedCode:    errcode.Internal,
+			expectedMessage: "внутренняя ошибка сервера",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			req = req.WithContext(requestid.NewContext(req.Context(), "req-1"))
+
+			tt.handle(recorder, req, tt.err)
+
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			assert.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+
+			var body dto.Error
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectedStatus, body.Status)
+			assert.Equal(t, string(tt.expectedCode), body.Code)
+			assert.Equal(t, tt.expectedMessage, body.Detail)
+			require.NotNil(t, body.RequestId)
+			assert.Equal(t, "req-1", *body.RequestId)
+		})
+	}
+}
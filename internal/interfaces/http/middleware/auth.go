@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"avito/internal/domain/auth"
+	"avito/internal/domain/errcode"
 )
 
 type ContextKey string
@@ -24,19 +25,19 @@ func RequireAuth(tokenParser AuthTokenParser, logger Logger) func(next http.Hand
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
-				respondWithError(w, http.StatusUnauthorized, "отсутствует токен авторизации", nil, logger)
+				RespondWithError(w, r, http.StatusUnauthorized, errcode.Unauthenticated, "отсутствует токен авторизации", nil, logger)
 				return
 			}
 
 			tokenParts := strings.Split(authHeader, " ")
 			if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
-				respondWithError(w, http.StatusUnauthorized, "неверный формат токена", nil, logger)
+				RespondWithError(w, r, http.StatusUnauthorized, errcode.InvalidToken, "неверный формат токена", nil, logger)
 				return
 			}
 
 			userID, role, err := tokenParser.ParseToken(tokenParts[1])
 			if err != nil {
-				respondWithError(w, http.StatusUnauthorized, "невалидный токен", err, logger)
+				RespondWithError(w, r, http.StatusUnauthorized, errcode.InvalidToken, "невалидный токен", err, logger)
 				return
 			}
 
@@ -53,12 +54,14 @@ func RequireRole(requiredRole auth.Role, logger Logger) func(next http.Handler)
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			role, ok := r.Context().Value(UserRoleKey).(auth.Role)
 			if !ok {
-				respondWithError(w, http.StatusUnauthorized, "отсутствует информация о пользователе", nil, logger)
+				RespondWithError(w, r, http.StatusUnauthorized, errcode.Unauthenticated,
+					"отсутствует информация о пользователе", nil, logger)
 				return
 			}
 
 			if role != requiredRole {
-				respondWithError(w, http.StatusForbidden, "недостаточно прав для выполнения операции", nil, logger)
+				RespondWithError(w, r, http.StatusForbidden, errcode.PermissionDenied,
+					"недостаточно прав для выполнения операции", nil, logger)
 				return
 			}
 
@@ -72,7 +75,8 @@ func RequireAnyRole(requiredRoles []auth.Role, logger Logger) func(next http.Han
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			role, ok := r.Context().Value(UserRoleKey).(auth.Role)
 			if !ok {
-				respondWithError(w, http.StatusUnauthorized, "отсутствует информация о пользователе", nil, logger)
+				RespondWithError(w, r, http.StatusUnauthorized, errcode.Unauthenticated,
+					"отсутствует информация о пользователе", nil, logger)
 				return
 			}
 
@@ -86,7 +90,8 @@ func RequireAnyRole(requiredRoles []auth.Role, logger Logger) func(next http.Han
 			}
 
 			if !hasRequiredRole {
-				respondWithError(w, http.StatusForbidden, "недостаточно прав для выполнения операции", nil, logger)
+				RespondWithError(w, r, http.StatusForbidden, errcode.PermissionDenied,
+					"недостаточно прав для выполнения операции", nil, logger)
 				return
 			}
 
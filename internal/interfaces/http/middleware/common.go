@@ -1,8 +1,11 @@
 package middleware
 
 import (
-	"encoding/json"
 	"net/http"
+
+	"avito/internal/domain/errcode"
+	"avito/internal/interfaces/http/problem"
+	"avito/internal/interfaces/http/requestid"
 )
 
 type Logger interface {
@@ -11,36 +14,13 @@ type Logger interface {
 	Debug(msg string, args ...any)
 }
 
-type Error struct {
-	Message string `json:"message"`
-}
-
-func respondWithJSON(w http.ResponseWriter, status int, payload interface{}) {
-	response, err := json.Marshal(payload)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = w.Write([]byte(`{"message":"Ошибка при формировании ответа"}`))
-
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	_, _ = w.Write(response)
-}
-
-func RespondWithError(w http.ResponseWriter, status int, message string, err error, logger Logger) {
+// RespondWithError логирует ошибку и отвечает описанием ошибки в формате application/problem+json.
+func RespondWithError(w http.ResponseWriter, r *http.Request, status int, code errcode.Code, message string, err error,
+	logger Logger) {
 	if logger != nil {
-		logger.Error(message, "error", err, "status", status)
-	}
-
-	errorResponse := Error{
-		Message: message,
+		logger.Error(message, "error", err, "status", status, "code", code,
+			"request_id", requestid.FromContext(r.Context()))
 	}
 
-	respondWithJSON(w, status, errorResponse)
-}
-
-func respondWithError(w http.ResponseWriter, status int, message string, err error, logger Logger) {
-	RespondWithError(w, status, message, err, logger)
+	problem.Write(w, problem.New(r.Context(), status, code, errcode.Detail{Text: message}))
 }
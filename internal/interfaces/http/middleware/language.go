@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"net/http"
+
+	"avito/internal/i18n"
+)
+
+// Language выбирает язык сообщений об ошибках по заголовку Accept-Language и кладет его в контекст.
+// Без заголовка или для неподдерживаемых языков используется русский.
+func Language() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lang := i18n.Negotiate(r.Header.Get("Accept-Language"))
+
+			w.Header().Add("Vary", "Accept-Language")
+
+			next.ServeHTTP(w, r.WithContext(i18n.NewContext(r.Context(), lang)))
+		})
+	}
+}
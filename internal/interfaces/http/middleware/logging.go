@@ -3,6 +3,8 @@ package middleware
 import (
 	"net/http"
 	"time"
+
+	"avito/internal/interfaces/http/requestid"
 )
 
 func RequestLogging(logger Logger) func(next http.Handler) http.Handler {
@@ -16,6 +18,7 @@ func RequestLogging(logger Logger) func(next http.Handler) http.Handler {
 			}
 
 			logger.Info("Получен запрос",
+				"request_id", requestid.FromContext(r.Context()),
 				"method", r.Method,
 				"path", r.URL.Path,
 				"remote_addr", r.RemoteAddr,
@@ -26,6 +29,7 @@ func RequestLogging(logger Logger) func(next http.Handler) http.Handler {
 
 			duration := time.Since(start)
 			logger.Info("Отправлен ответ",
+				"request_id", requestid.FromContext(r.Context()),
 				"method", r.Method,
 				"path", r.URL.Path,
 				"status", rw.statusCode,
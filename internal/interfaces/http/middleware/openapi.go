@@ -0,0 +1,231 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"avito/internal/domain/errcode"
+	"avito/internal/interfaces/http/dto"
+	"avito/internal/interfaces/http/problem"
+	"avito/internal/metrics"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/google/uuid"
+)
+
+// OpenAPIValidation проверяет параметры пути, строки запроса и JSON-тело запроса по спецификации.
+// Аутентификацию middleware не проверяет: она выполняется раньше, в RequireAuth.
+// При validateResponses ответы тоже сверяются со спецификацией; расхождения не меняют ответ,
+// а попадают в лог и метрику app_openapi_violations_total.
+func OpenAPIValidation(spec *openapi3.T, validateResponses bool, logger Logger) (func(next http.Handler) http.Handler, error) {
+	defineFormatsOnce.Do(defineFormats)
+
+	// Серверы из спецификации не должны влиять на сопоставление маршрутов по хосту.
+	specCopy := *spec
+	specCopy.Servers = nil
+
+	router, err := gorillamux.NewRouter(&specCopy)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при построении маршрутов из спецификации: %w", err)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				// Неизвестные пути и методы обрабатывает роутер приложения.
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options: &openapi3filter.Options{
+					MultiError:          true,
+					SkipSettingDefaults: true,
+					AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+				},
+			}
+
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				metrics.OpenAPIViolationsTotal.WithLabelValues("request", route.Operation.OperationID).Inc()
+
+				violations := requestViolations(err)
+
+				body := problem.New(r.Context(), http.StatusBadRequest, errcode.InvalidRequest,
+					errcode.Detail{Key: errcode.DetailSpecViolation, Text: "запрос не соответствует спецификации API"})
+				body.Errors = &violations
+
+				problem.Write(w, body)
+
+				return
+			}
+
+			if !validateResponses {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &responseRecorder{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+			}
+
+			next.ServeHTTP(rec, r)
+
+			validateResponse(r, input, rec, logger)
+		})
+	}, nil
+}
+
+func validateResponse(r *http.Request, input *openapi3filter.RequestValidationInput, rec *responseRecorder, logger Logger) {
+	// Ошибки сервера в спецификации не описываются.
+	if rec.statusCode >= http.StatusInternalServerError {
+		return
+	}
+
+	err := openapi3filter.ValidateResponse(r.Context(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 rec.statusCode,
+		Header:                 rec.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(rec.body.Bytes())),
+		Options: &openapi3filter.Options{
+			MultiError:            true,
+			IncludeResponseStatus: true,
+		},
+	})
+	if err == nil {
+		return
+	}
+
+	operation := input.Route.Operation.OperationID
+	metrics.OpenAPIViolationsTotal.WithLabelValues("response", operation).Inc()
+
+	logger.Error("Ответ не соответствует спецификации API",
+		"operation", operation,
+		"method", r.Method,
+		"path", r.URL.Path,
+		"status", rec.statusCode,
+		"error", err,
+	)
+}
+
+var defineFormatsOnce sync.Once
+
+// defineFormats регистрирует форматы спецификации, которые kin-openapi не проверяет по умолчанию.
+// UUID разбирается так же, как в сгенерированном коде, чтобы валидация и привязка параметров не расходились.
+func defineFormats() {
+	openapi3.DefineStringFormatValidator("uuid", openapi3.NewCallbackValidator(func(value string) error {
+		_, err := uuid.Parse(value)
+		return err
+	}))
+	openapi3.DefineStringFormatValidator("email", openapi3.NewRegexpFormatValidator(openapi3.FormatOfStringForEmail))
+}
+
+// requestViolations раскладывает ошибку валидации на отдельные нарушения.
+func requestViolations(err error) []dto.Violation {
+	// Только верхний уровень: errors.As развернул бы и MultiError внутри RequestError параметра.
+	if multiErr, ok := err.(openapi3.MultiError); ok { //nolint:errorlint // см. выше
+		var result []dto.Violation
+		for _, e := range multiErr {
+			result = append(result, requestViolations(e)...)
+		}
+
+		return result
+	}
+
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return []dto.Violation{{In: dto.ViolationInBody, Message: err.Error()}}
+	}
+
+	switch {
+	case reqErr.Parameter != nil:
+		return causeViolations(dto.ViolationIn(reqErr.Parameter.In), reqErr.Parameter.Name, reqErr)
+	case reqErr.RequestBody != nil:
+		return causeViolations(dto.ViolationInBody, "", reqErr)
+	default:
+		// Например, неподдерживаемый Content-Type.
+		return []dto.Violation{{In: dto.ViolationInHeader, Message: reqErr.Error()}}
+	}
+}
+
+// causeViolations возвращает по нарушению на каждую ошибку схемы внутри RequestError.
+// Для тела запроса имя - JSON Pointer поля с ошибкой.
+func causeViolations(in dto.ViolationIn, name string, reqErr *openapi3filter.RequestError) []dto.Violation {
+	causes := []error{reqErr.Err}
+
+	var multiErr openapi3.MultiError
+	if errors.As(reqErr.Err, &multiErr) {
+		causes = multiErr
+	}
+
+	result := make([]dto.Violation, 0, len(causes))
+
+	for _, cause := range causes {
+		violation := dto.Violation{
+			In:      in,
+			Message: reqErr.Reason,
+		}
+
+		if name != "" {
+			violation.Name = &name
+		}
+
+		var (
+			schemaErr *openapi3.SchemaError
+			parseErr  *openapi3filter.ParseError
+		)
+
+		switch {
+		case errors.As(cause, &schemaErr):
+			violation.Message = schemaErr.Reason
+
+			if in == dto.ViolationInBody {
+				pointer := "/" + strings.Join(schemaErr.JSONPointer(), "/")
+				violation.Name = &pointer
+			}
+		case errors.As(cause, &parseErr):
+			violation.Message = parseErr.Error()
+		case cause != nil && violation.Message == "":
+			violation.Message = cause.Error()
+		}
+
+		result = append(result, violation)
+	}
+
+	return result
+}
+
+// responseRecorder пропускает ответ клиенту и сохраняет копию для проверки по спецификации.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func (rec *responseRecorder) WriteHeader(code int) {
+	if !rec.wroteHeader {
+		rec.statusCode = code
+		rec.wroteHeader = true
+	}
+
+	rec.ResponseWriter.WriteHeader(code)
+}
+
+func (rec *responseRecorder) Write(data []byte) (int, error) {
+	rec.wroteHeader = true
+	rec.body.Write(data)
+
+	return rec.ResponseWriter.Write(data)
+}
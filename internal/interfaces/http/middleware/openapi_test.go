@@ -0,0 +1,227 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"avito/internal/interfaces/http/dto"
+	"avito/internal/interfaces/http/middleware"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPVZID = "123e4567-e89b-12d3-a456-426614174000"
+
+// violation - часть нарушения, которая не зависит от текста сообщения kin-openapi.
+type violation struct {
+	In   dto.ViolationIn
+	Name string
+}
+
+func TestOpenAPIValidation_Request(t *testing.T) {
+	spec, err := dto.GetSwagger()
+	require.NoError(t, err)
+
+	validation, err := middleware.OpenAPIValidation(spec, false, slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil)))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		method             string
+		target             string
+		body               string
+		expectedStatus     int
+		expectedViolations []violation
+	}{
+		{
+			name:           "Корректный запрос",
+			method:         http.MethodPost,
+			target:         "/products",
+			body:           `{"type":"обувь","pvzId":"` + testPVZID + `"}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Ошибки в нескольких полях тела",
+			method:         http.MethodPost,
+			target:         "/products",
+			body:           `{"type":"мебель","pvzId":"not-a-uuid"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedViolations: []violation{
+				{In: "body", Name: "/pvzId"},
+				{In: "body", Name: "/type"},
+			},
+		},
+		{
+			name:           "Отсутствует обязательное поле",
+			method:         http.MethodPost,
+			target:         "/receptions",
+			body:           `{}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedViolations: []violation{
+				{In: "body", Name: "/pvzId"},
+			},
+		},
+		{
+			name:           "Неверные параметры строки запроса",
+			method:         http.MethodGet,
+			target:         "/pvz?page=0&limit=100&city=Тверь",
+			expectedStatus: http.StatusBadRequest,
+			expectedViolations: []violation{
+				{In: "query", Name: "city"},
+				{In: "query", Name: "limit"},
+				{In: "query", Name: "page"},
+			},
+		},
+		{
+			name:           "Неверный параметр пути",
+			method:         http.MethodGet,
+			target:         "/pvz/not-a-uuid",
+			expectedStatus: http.StatusBadRequest,
+			expectedViolations: []violation{
+				{In: "path", Name: "pvzId"},
+			},
+		},
+		{
+			name:           "Маршрут вне спецификации передается дальше",
+			method:         http.MethodGet,
+			target:         "/unknown",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+
+			handler := validation(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			assert.Equal(t, tt.expectedStatus == http.StatusOK, called)
+
+			if tt.expectedViolations == nil {
+				return
+			}
+
+			assert.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+
+			var body dto.Error
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+			assert.Equal(t, "INVALID_REQUEST", body.Code)
+			assert.Equal(t, http.StatusBadRequest, body.Status)
+			require.NotNil(t, body.Errors)
+
+			got := make([]violation, 0, len(*body.Errors))
+			for _, v := range *body.Errors {
+				assert.NotEmpty(t, v.Message)
+				require.NotNil(t, v.Name)
+				got = append(got, violation{In: v.In, Name: *v.Name})
+			}
+
+			assert.ElementsMatch(t, tt.expectedViolations, got)
+		})
+	}
+}
+
+func TestOpenAPIValidation_Response(t *testing.T) {
+	spec, err := dto.GetSwagger()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		validateResponses bool
+		status            int
+		contentType       string
+		body              string
+		expectViolation   bool
+	}{
+		{
+			name:              "Ответ соответствует спецификации",
+			validateResponses: true,
+			status:            http.StatusOK,
+			body:              `{"pvz":{"id":"` + testPVZID + `","city":"Казань"},"receptions":[]}`,
+		},
+		{
+			name:              "Неверное значение в ответе",
+			validateResponses: true,
+			status:            http.StatusOK,
+			body:              `{"pvz":{"id":"` + testPVZID + `","city":"Тверь"}}`,
+			expectViolation:   true,
+		},
+		{
+			name:              "Ошибка в формате problem+json",
+			validateResponses: true,
+			status:            http.StatusNotFound,
+			contentType:       "application/problem+json",
+			body: `{"type":"urn:pvz.avito:problem:pvz-not-found","title":"Not Found","status":404,` +
+				`"detail":"ПВЗ не найден","code":"PVZ_NOT_FOUND"}`,
+		},
+		{
+			name:              "Ошибка без обязательных полей problem+json",
+			validateResponses: true,
+			status:            http.StatusNotFound,
+			contentType:       "application/problem+json",
+			body:              `{"message":"ПВЗ не найден"}`,
+			expectViolation:   true,
+		},
+		{
+			name:              "Статус не описан в спецификации",
+			validateResponses: true,
+			status:            http.StatusConflict,
+			body:              `{"message":"конфликт"}`,
+			expectViolation:   true,
+		},
+		{
+			name:              "Проверка ответов выключена",
+			validateResponses: false,
+			status:            http.StatusOK,
+			body:              `{"pvz":{"id":"` + testPVZID + `","city":"Тверь"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs := bytes.NewBuffer(nil)
+
+			validation, err := middleware.OpenAPIValidation(spec, tt.validateResponses, slog.New(slog.NewTextHandler(logs, nil)))
+			require.NoError(t, err)
+
+			contentType := tt.contentType
+			if contentType == "" {
+				contentType = "application/json"
+			}
+
+			handler := validation(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", contentType)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/pvz/"+testPVZID, http.NoBody))
+
+			// Ответ клиенту не меняется независимо от результата проверки.
+			assert.Equal(t, tt.status, recorder.Code)
+			assert.Equal(t, tt.body, recorder.Body.String())
+			assert.Equal(t, tt.expectViolation, strings.Contains(logs.String(), "Ответ не соответствует спецификации API"))
+		})
+	}
+}
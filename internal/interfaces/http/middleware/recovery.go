@@ -3,6 +3,10 @@ package middleware
 import (
 	"net/http"
 	"runtime/debug"
+
+	"avito/internal/domain/errcode"
+	"avito/internal/interfaces/http/problem"
+	"avito/internal/interfaces/http/requestid"
 )
 
 func Recovery(logger Logger) func(next http.Handler) http.Handler {
@@ -12,14 +16,14 @@ func Recovery(logger Logger) func(next http.Handler) http.Handler {
 				if err := recover(); err != nil {
 					logger.Error("Внутренняя ошибка сервера",
 						"error", err,
+						"request_id", requestid.FromContext(r.Context()),
 						"stack", string(debug.Stack()),
 						"path", r.URL.Path,
 						"method", r.Method,
 					)
 
-					w.WriteHeader(http.StatusInternalServerError)
-					w.Header().Set("Content-Type", "application/json")
-					_, _ = w.Write([]byte(`{"message":"Внутренняя ошибка сервера"}`))
+					problem.Write(w, problem.New(r.Context(), http.StatusInternalServerError, errcode.Internal,
+						errcode.Detail{Text: "внутренняя ошибка сервера"}))
 				}
 			}()
 
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+
+	"avito/internal/interfaces/http/requestid"
+
+	"github.com/google/uuid"
+)
+
+// maxRequestIDLength ограничивает идентификатор, пришедший от клиента, чтобы он не раздувал логи.
+const maxRequestIDLength = 128
+
+// RequestID кладет в контекст идентификатор запроса и возвращает его в заголовке X-Request-ID.
+// Идентификатор клиента используется, если он непустой и состоит из печатных ASCII-символов,
+// иначе генерируется новый.
+func RequestID() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(requestid.Header)
+			if !validRequestID(id) {
+				id = uuid.NewString()
+			}
+
+			w.Header().Set(requestid.Header, id)
+
+			next.ServeHTTP(w, r.WithContext(requestid.NewContext(r.Context(), id)))
+		})
+	}
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,70 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"avito/internal/interfaces/http/middleware"
+	"avito/internal/interfaces/http/requestid"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     string
+		expectSame bool
+	}{
+		{
+			name:       "Идентификатор клиента сохраняется",
+			header:     "client-request-42",
+			expectSame: true,
+		},
+		{
+			name:   "Без заголовка генерируется новый",
+			header: "",
+		},
+		{
+			name:   "Идентификатор с пробелами заменяется",
+			header: "bad id",
+		},
+		{
+			name:   "Слишком длинный идентификатор заменяется",
+			header: strings.Repeat("a", 129),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fromContext string
+
+			handler := middleware.RequestID()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				fromContext = requestid.FromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/pvz", http.NoBody)
+			if tt.header != "" {
+				req.Header.Set(requestid.Header, tt.header)
+			}
+
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, req)
+
+			id := recorder.Header().Get(requestid.Header)
+			assert.Equal(t, id, fromContext)
+
+			if tt.expectSame {
+				assert.Equal(t, tt.header, id)
+				return
+			}
+
+			_, err := uuid.Parse(id)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package problem формирует ответы с ошибками в формате RFC 7807 (application/problem+json).
+// Один и тот же формат используют обработчики и middleware.
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"avito/internal/domain/errcode"
+	"avito/internal/i18n"
+	"avito/internal/interfaces/http/dto"
+	"avito/internal/interfaces/http/requestid"
+)
+
+// ContentType - тип содержимого ответов с ошибкой.
+const ContentType = "application/problem+json"
+
+// typePrefix - префикс URI типа ошибки. URI не обязан открываться, он лишь идентифицирует тип.
+const typePrefix = "urn:pvz.avito:problem:"
+
+// Type возвращает URI типа ошибки для кода, например urn:pvz.avito:problem:pvz-not-found.
+func Type(code errcode.Code) string {
+	return typePrefix + strings.ToLower(strings.ReplaceAll(string(code), "_", "-"))
+}
+
+// New возвращает описание ошибки с идентификатором запроса из контекста.
+// Сообщение detail переводится на язык клиента из контекста (см. i18n.Message).
+func New(ctx context.Context, status int, code errcode.Code, detail errcode.Detail) dto.Error {
+	body := dto.Error{
+		Type:   Type(code),
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: i18n.Message(i18n.FromContext(ctx), code, detail),
+		Code:   string(code),
+	}
+
+	if id := requestid.FromContext(ctx); id != "" {
+		body.RequestId = &id
+	}
+
+	return body
+}
+
+// Write отправляет описание ошибки со статусом из body.Status.
+func Write(w http.ResponseWriter, body dto.Error) {
+	response, err := json.Marshal(body)
+	if err != nil {
+		w.Header().Set("Content-Type", ContentType)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"type":"` + Type(errcode.Internal) + `","title":"Internal Server Error",` +
+			`"status":500,"detail":"Ошибка при формировании ответа","code":"INTERNAL"}`))
+
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(body.Status)
+	_, _ = w.Write(response)
+}
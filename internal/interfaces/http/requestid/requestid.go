@@ -0,0 +1,21 @@
+// Package requestid хранит идентификатор HTTP-запроса в контексте.
+// Идентификатор выставляет middleware.RequestID, а читают логирование и ответы с ошибками.
+package requestid
+
+import "context"
+
+// Header - заголовок, в котором идентификатор принимается от клиента и возвращается в ответе.
+const Header = "X-Request-ID"
+
+type contextKey struct{}
+
+// NewContext возвращает контекст с идентификатором запроса.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext возвращает идентификатор запроса или пустую строку, если его нет.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
@@ -1,8 +1,10 @@
 package http
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"avito/internal/application/auth"
@@ -10,7 +12,9 @@ import (
 	"avito/internal/application/pvz"
 	"avito/internal/application/reception"
 	domainAuth "avito/internal/domain/auth"
+	"avito/internal/domain/errcode"
 	"avito/internal/interfaces/http/adapters"
+	"avito/internal/interfaces/http/dto"
 	"avito/internal/interfaces/http/handlers"
 	"avito/internal/interfaces/http/middleware"
 )
@@ -20,131 +24,194 @@ type Router struct {
 	logger  *slog.Logger
 }
 
-//nolint:funlen // функция инициализации роутера должна быть целостной для наглядности и поддержки
+var (
+	employeeOnly  = []domainAuth.Role{domainAuth.RoleEmployee}
+	moderatorOnly = []domainAuth.Role{domainAuth.RoleModerator}
+	anyStaff      = []domainAuth.Role{domainAuth.RoleEmployee, domainAuth.RoleModerator}
+)
+
+// accessRules задает роли для каждого маршрута спецификации. Маршрут без ролей публичный и не требует токена.
+// Маршруты регистрирует сгенерированный код, поэтому расхождение таблицы со спецификацией
+// обнаруживается при создании роутера.
+var accessRules = map[string][]domainAuth.Role{
+	"POST /dummyLogin": nil,
+	"POST /login":      nil,
+	"POST /register":   nil,
+
+	"GET /pvz":                               anyStaff,
+	"POST /pvz":                              moderatorOnly,
+	"GET /pvz/batch":                         anyStaff,
+	"GET /pvz/{pvzId}":                       anyStaff,
+	"GET /pvz/{pvzId}/receptions":            anyStaff,
+	"POST /pvz/{pvzId}/close_last_reception": employeeOnly,
+	"POST /pvz/{pvzId}/delete_last_product":  employeeOnly,
+	"POST /receptions":                       employeeOnly,
+	"POST /products":                         employeeOnly,
+	"GET /receptions/{receptionId}/products": anyStaff,
+}
+
 func NewRouter(
 	authSvc *auth.Service,
 	pvzSvc *pvz.Service,
 	receptionSvc *reception.Service,
 	productSvc *product.Service,
+	validateResponses bool,
 	logger *slog.Logger,
 ) *Router {
-	router := &Router{
-		logger: logger,
-	}
-
 	authAdapter := adapters.NewAuthServiceAdapter(authSvc)
 	pvzAdapter := adapters.NewPVZServiceAdapter(pvzSvc)
 	receptionAdapter := adapters.NewReceptionServiceAdapter(receptionSvc)
 	productAdapter := adapters.NewProductServiceAdapter(productSvc)
 
-	authHandler := handlers.NewAuthHandler(authAdapter, logger)
-	pvzHandler := handlers.NewPVZHandler(pvzAdapter, logger)
-	receptionHandler := handlers.NewReceptionHandler(receptionAdapter, logger)
-	productHandler := handlers.NewProductHandler(productAdapter, logger)
+	server := handlers.NewServer(
+		handlers.NewAuthHandler(authAdapter),
+		handlers.NewPVZHandler(pvzAdapter),
+		handlers.NewReceptionHandler(receptionAdapter),
+		handlers.NewProductHandler(productAdapter),
+	)
 
-	publicMux := http.NewServeMux()
+	strictHandler := dto.NewStrictHandlerWithOptions(server, nil, dto.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  handlers.RequestErrorHandler(logger),
+		ResponseErrorHandlerFunc: handlers.ResponseErrorHandler(logger),
+	})
 
-	publicMux.HandleFunc("/dummyLogin", authHandler.DummyLogin)
-	publicMux.HandleFunc("/login", authHandler.Login)
-	publicMux.HandleFunc("/register", authHandler.Register)
+	tokenParser := &tokenParser{
+		authService: authSvc,
+	}
 
-	protectedMux := http.NewServeMux()
+	spec, err := dto.GetSwagger()
+	if err != nil {
+		panic(fmt.Sprintf("ошибка при загрузке встроенной спецификации: %v", err))
+	}
 
-	protectedMux.HandleFunc("/pvz", func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/pvz" {
-			http.NotFound(w, r)
-			return
-		}
+	validationMiddleware, err := middleware.OpenAPIValidation(spec, validateResponses, logger)
+	if err != nil {
+		panic(err)
+	}
 
-		switch r.Method {
-		case http.MethodGet:
-			pvzHandler.GetPVZs(w, r)
-		case http.MethodPost:
-			role, ok := r.Context().Value(middleware.UserRoleKey).(domainAuth.Role)
-			if !ok || role != domainAuth.RoleModerator {
-				middleware.RespondWithError(w, http.StatusForbidden, "недостаточно прав для выполнения операции", nil, logger)
-				return
-			}
-
-			pvzHandler.CreatePVZ(w, r)
-		default:
-			w.WriteHeader(http.StatusMethodNotAllowed)
-		}
+	mux := newAccessMux(accessRules, middleware.RequireAuth(tokenParser, logger), validationMiddleware, logger)
+
+	dto.HandlerWithOptions(strictHandler, dto.StdHTTPServerOptions{
+		BaseRouter:       mux,
+		ErrorHandlerFunc: handlers.ParamErrorHandler(logger),
 	})
 
-	protectedMux.HandleFunc("/pvz/", func(w http.ResponseWriter, r *http.Request) {
-		path := r.URL.Path
+	mux.mustCoverRules()
 
-		role, ok := r.Context().Value(middleware.UserRoleKey).(domainAuth.Role)
-		if !ok || role != domainAuth.RoleEmployee {
-			middleware.RespondWithError(w, http.StatusForbidden, "недостаточно прав для выполнения операции", nil, logger)
-			return
-		}
+	loggerMiddleware := middleware.RequestLogging(logger)
+	recoveryMiddleware := middleware.Recovery(logger)
+	metricsMiddleware := middleware.Metrics()
+	requestIDMiddleware := middleware.RequestID()
+	languageMiddleware := middleware.Language()
 
-		if strings.HasSuffix(path, "/close_last_reception") {
-			receptionHandler.CloseLastReception(w, r)
-			return
-		}
+	return &Router{
+		handler: requestIDMiddleware(languageMiddleware(loggerMiddleware(metricsMiddleware(recoveryMiddleware(mux))))),
+		logger:  logger,
+	}
+}
 
-		if strings.HasSuffix(path, "/delete_last_product") {
-			productHandler.DeleteLastProduct(w, r)
-			return
-		}
+func (r *Router) Handler() http.Handler {
+	return r.handler
+}
 
-		http.NotFound(w, r)
-	})
+// accessMux - ServeMux, который при регистрации маршрута оборачивает обработчик проверкой
+// токена и роли из таблицы доступа и валидацией по спецификации, а на неизвестные пути и методы
+// отвечает ошибкой в формате application/problem+json.
+type accessMux struct {
+	mux            *http.ServeMux
+	rules          map[string][]domainAuth.Role
+	registered     map[string]bool
+	methods        []string
+	authMiddleware func(http.Handler) http.Handler
+	validation     func(http.Handler) http.Handler
+	logger         *slog.Logger
+}
 
-	protectedMux.HandleFunc("/receptions", func(w http.ResponseWriter, r *http.Request) {
-		role, ok := r.Context().Value(middleware.UserRoleKey).(domainAuth.Role)
-		if !ok || role != domainAuth.RoleEmployee {
-			middleware.RespondWithError(w, http.StatusForbidden, "недостаточно прав для выполнения операции", nil, logger)
-			return
-		}
+func newAccessMux(rules map[string][]domainAuth.Role, authMiddleware, validation func(http.Handler) http.Handler,
+	logger *slog.Logger) *accessMux {
+	return &accessMux{
+		mux:            http.NewServeMux(),
+		rules:          rules,
+		registered:     make(map[string]bool, len(rules)),
+		authMiddleware: authMiddleware,
+		validation:     validation,
+		logger:         logger,
+	}
+}
 
-		receptionHandler.CreateReception(w, r)
-	})
+func (m *accessMux) HandleFunc(pattern string, handlerFunc func(http.ResponseWriter, *http.Request)) {
+	roles, ok := m.rules[pattern]
+	if !ok {
+		panic(fmt.Sprintf("маршрут %q не описан в таблице доступа", pattern))
+	}
 
-	protectedMux.HandleFunc("/products", func(w http.ResponseWriter, r *http.Request) {
-		role, ok := r.Context().Value(middleware.UserRoleKey).(domainAuth.Role)
-		if !ok || role != domainAuth.RoleEmployee {
-			middleware.RespondWithError(w, http.StatusForbidden, "недостаточно прав для выполнения операции", nil, logger)
-			return
-		}
+	// Запрос проверяется по спецификации только после аутентификации и проверки роли.
+	handler := m.validation(http.HandlerFunc(handlerFunc))
 
-		productHandler.CreateProduct(w, r)
-	})
+	switch len(roles) {
+	case 0:
+	case 1:
+		handler = m.authMiddleware(middleware.RequireRole(roles[0], m.logger)(handler))
+	default:
+		handler = m.authMiddleware(middleware.RequireAnyRole(roles, m.logger)(handler))
+	}
 
-	loggerMiddleware := middleware.RequestLogging(logger)
-	recoveryMiddleware := middleware.Recovery(logger)
-	metricsMiddleware := middleware.Metrics()
+	m.mux.Handle(pattern, handler)
+	m.registered[pattern] = true
 
-	tokenParser := &tokenParser{
-		authService: authSvc,
+	method, _, _ := strings.Cut(pattern, " ")
+	m.methods = append(m.methods, method)
+
+	// ServeMux обслуживает HEAD шаблоном GET.
+	if method == http.MethodGet {
+		m.methods = append(m.methods, http.MethodHead)
 	}
 
-	authMiddleware := middleware.RequireAuth(tokenParser, logger)
-	protectedHandler := authMiddleware(protectedMux)
+	slices.Sort(m.methods)
+	m.methods = slices.Compact(m.methods)
+}
+
+// mustCoverRules проверяет, что в таблице доступа нет маршрутов, которых нет в спецификации.
+func (m *accessMux) mustCoverRules() {
+	for pattern := range m.rules {
+		if !m.registered[pattern] {
+			panic(fmt.Sprintf("маршрут %q из таблицы доступа отсутствует в спецификации", pattern))
+		}
+	}
+}
+
+func (m *accessMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if _, pattern := m.mux.Handler(r); pattern != "" {
+		m.mux.ServeHTTP(w, r)
+		return
+	}
 
-	finalMux := http.NewServeMux()
+	allowed := m.allowedMethods(r)
+	if len(allowed) == 0 {
+		middleware.RespondWithError(w, r, http.StatusNotFound, errcode.RouteNotFound, "ресурс не найден", nil, nil)
+		return
+	}
 
-	finalMux.Handle("/dummyLogin", publicMux)
-	finalMux.Handle("/login", publicMux)
-	finalMux.Handle("/register", publicMux)
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	middleware.RespondWithError(w, r, http.StatusMethodNotAllowed, errcode.MethodNotAllowed, "метод не поддерживается",
+		nil, nil)
+}
 
-	finalMux.Handle("/pvz", protectedHandler)
-	finalMux.Handle("/pvz/", protectedHandler)
-	finalMux.Handle("/receptions", protectedHandler)
-	finalMux.Handle("/products", protectedHandler)
+// allowedMethods возвращает методы, для которых есть маршрут с путем запроса.
+func (m *accessMux) allowedMethods(r *http.Request) []string {
+	var allowed []string
 
-	handler := loggerMiddleware(metricsMiddleware(recoveryMiddleware(finalMux)))
+	probe := r.Clone(r.Context())
 
-	router.handler = handler
+	for _, method := range m.methods {
+		probe.Method = method
 
-	return router
-}
+		if _, pattern := m.mux.Handler(probe); pattern != "" {
+			allowed = append(allowed, method)
+		}
+	}
 
-func (r *Router) Handler() http.Handler {
-	return r.handler
+	return allowed
 }
 
 type tokenParser struct {
@@ -0,0 +1,262 @@
+package http_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"avito/internal/application/auth"
+	"avito/internal/application/product"
+	"avito/internal/application/pvz"
+	"avito/internal/application/reception"
+	domainAuth "avito/internal/domain/auth"
+	"avito/internal/domain/errcode"
+	httpServer "avito/internal/interfaces/http"
+	"avito/internal/interfaces/http/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPVZPath = "/pvz/123e4567-e89b-12d3-a456-426614174000"
+
+func TestRouter_Policies(t *testing.T) {
+	// Сервисы без репозиториев: проверяемые запросы не должны доходить до обработчиков.
+	authSvc := auth.NewService(nil, nil, "test-secret", time.Hour)
+	nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
+
+	router := httpServer.NewRouter(
+		authSvc,
+		pvz.NewService(nil, nil),
+		reception.NewService(nil, nil, nil, nil),
+		product.NewService(nil, nil, nil, nil, nil),
+		true,
+		nullLogger,
+	)
+
+	token := func(role domainAuth.Role) string {
+		res, err := authSvc.DummyLogin(context.Background(), domainAuth.DummyLoginRequest{Role: role})
+		require.NoError(t, err)
+
+		return res.Token
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		role           domainAuth.Role
+		expectedStatus int
+		expectedCode   errcode.Code
+		expectedAllow  string
+	}{
+		{
+			name:           "Неподдерживаемый метод для /pvz",
+			method:         http.MethodDelete,
+			path:           "/pvz",
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   errcode.MethodNotAllowed,
+			expectedAllow:  "GET, HEAD, POST",
+		},
+		{
+			name:           "GET для закрытия приемки",
+			method:         http.MethodGet,
+			path:           testPVZPath + "/close_last_reception",
+			role:           domainAuth.RoleEmployee,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   errcode.MethodNotAllowed,
+			expectedAllow:  "POST",
+		},
+		{
+			name:           "PUT для ПВЗ по ID",
+			method:         http.MethodPut,
+			path:           testPVZPath,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   errcode.MethodNotAllowed,
+			expectedAllow:  "GET, HEAD",
+		},
+		{
+			name:           "GET для /dummyLogin",
+			method:         http.MethodGet,
+			path:           "/dummyLogin",
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   errcode.MethodNotAllowed,
+			expectedAllow:  "POST",
+		},
+		{
+			name:           "Неизвестный путь",
+			method:         http.MethodGet,
+			path:           "/unknown",
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   errcode.RouteNotFound,
+		},
+		{
+			name:           "Неизвестное действие с ПВЗ",
+			method:         http.MethodPost,
+			path:           testPVZPath + "/unknown",
+			role:           domainAuth.RoleEmployee,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   errcode.RouteNotFound,
+		},
+		{
+			name:           "Список ПВЗ без токена",
+			method:         http.MethodGet,
+			path:           "/pvz",
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   errcode.Unauthenticated,
+		},
+		{
+			name:           "Приемки ПВЗ без токена",
+			method:         http.MethodGet,
+			path:           testPVZPath + "/receptions",
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   errcode.Unauthenticated,
+		},
+		{
+			name:           "Лимит товаров приемки больше максимального",
+			method:         http.MethodGet,
+			path:           "/receptions/223e4567-e89b-12d3-a456-426614174000/products?limit=501",
+			role:           domainAuth.RoleModerator,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   errcode.InvalidRequest,
+		},
+		{
+			name:           "Создание ПВЗ сотрудником",
+			method:         http.MethodPost,
+			path:           "/pvz",
+			role:           domainAuth.RoleEmployee,
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   errcode.PermissionDenied,
+		},
+		{
+			name:           "Закрытие приемки модератором",
+			method:         http.MethodPost,
+			path:           testPVZPath + "/close_last_reception",
+			role:           domainAuth.RoleModerator,
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   errcode.PermissionDenied,
+		},
+		{
+			name:           "Удаление товара модератором",
+			method:         http.MethodPost,
+			path:           testPVZPath + "/delete_last_product",
+			role:           domainAuth.RoleModerator,
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   errcode.PermissionDenied,
+		},
+		{
+			name:           "Создание приемки модератором",
+			method:         http.MethodPost,
+			path:           "/receptions",
+			role:           domainAuth.RoleModerator,
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   errcode.PermissionDenied,
+		},
+		{
+			name:           "Добавление товара модератором",
+			method:         http.MethodPost,
+			path:           "/products",
+			role:           domainAuth.RoleModerator,
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   errcode.PermissionDenied,
+		},
+		{
+			name:           "Неверный UUID ПВЗ",
+			method:         http.MethodGet,
+			path:           "/pvz/invalid-uuid",
+			role:           domainAuth.RoleModerator,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   errcode.InvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, http.NoBody)
+			if tt.role != "" {
+				req.Header.Set("Authorization", "Bearer "+token(tt.role))
+			}
+
+			recorder := httptest.NewRecorder()
+
+			router.Handler().ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			assert.Equal(t, tt.expectedAllow, recorder.Header().Get("Allow"))
+			assert.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+			assert.NotEmpty(t, recorder.Header().Get("X-Request-ID"))
+
+			var body dto.Error
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+			assert.Equal(t, string(tt.expectedCode), body.Code)
+			assert.Equal(t, tt.expectedStatus, body.Status)
+			require.NotNil(t, body.RequestId)
+			assert.Equal(t, recorder.Header().Get("X-Request-ID"), *body.RequestId)
+		})
+	}
+}
+
+func TestRouter_Localization(t *testing.T) {
+	nullLogger := slog.New(slog.NewTextHandler(bytes.NewBuffer(nil), nil))
+
+	router := httpServer.NewRouter(
+		auth.NewService(nil, nil, "test-secret", time.Hour),
+		pvz.NewService(nil, nil),
+		reception.NewService(nil, nil, nil, nil),
+		product.NewService(nil, nil, nil, nil, nil),
+		true,
+		nullLogger,
+	)
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expectedDetail string
+	}{
+		{
+			name:           "Без заголовка",
+			expectedDetail: "отсутствует токен авторизации",
+		},
+		{
+			name:           "Английский",
+			acceptLanguage: "en-US,en;q=0.9",
+			expectedDetail: "authorization token is missing",
+		},
+		{
+			name:           "Английский предпочтительнее русского",
+			acceptLanguage: "ru;q=0.5, en;q=0.8",
+			expectedDetail: "authorization token is missing",
+		},
+		{
+			name:           "Неподдерживаемый язык",
+			acceptLanguage: "fr-FR",
+			expectedDetail: "отсутствует токен авторизации",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/pvz", http.NoBody)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+
+			recorder := httptest.NewRecorder()
+
+			router.Handler().ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+			assert.Equal(t, "Accept-Language", recorder.Header().Get("Vary"))
+
+			var body dto.Error
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+			assert.Equal(t, string(errcode.Unauthenticated), body.Code)
+			assert.Equal(t, tt.expectedDetail, body.Detail)
+		})
+	}
+}
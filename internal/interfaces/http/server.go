@@ -23,9 +23,10 @@ func New(
 	pvzSvc *pvz.Service,
 	receptionSvc *reception.Service,
 	productSvc *product.Service,
+	validateResponses bool,
 	logger *slog.Logger,
 ) *Server {
-	router := NewRouter(authSvc, pvzSvc, receptionSvc, productSvc, logger)
+	router := NewRouter(authSvc, pvzSvc, receptionSvc, productSvc, validateResponses, logger)
 
 	server := &Server{
 		server: &http.Server{
@@ -69,7 +69,7 @@ func StartServer(addr string) *http.Server {
 var (
 	RequestsTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "app_requests_total",
-		Help: "Общее количество HTTP запросов",
+		Help: "Общее количество HTTP и gRPC запросов",
 	})
 
 	ResponseTime = promauto.NewHistogram(prometheus.HistogramOpts{
@@ -86,6 +86,31 @@ var (
 		[]string{"status"},
 	)
 
+	GRPCRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "app_grpc_requests_total",
+			Help: "Количество gRPC запросов по методам и кодам ответа",
+		},
+		[]string{"method", "code"},
+	)
+
+	GRPCResponseTime = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "app_grpc_response_time_seconds",
+			Help:    "Время ответа gRPC методов в секундах",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 2},
+		},
+		[]string{"method"},
+	)
+
+	OpenAPIViolationsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "app_openapi_violations_total",
+			Help: "Количество HTTP запросов и ответов, не соответствующих OpenAPI-спецификации",
+		},
+		[]string{"direction", "operation"},
+	)
+
 	PVZCreatedTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "app_pvz_created_total",
 		Help: "Общее количество созданных ПВЗ",
@@ -100,4 +125,31 @@ var (
 		Name: "app_products_added_total",
 		Help: "Общее количество добавленных товаров",
 	})
+
+	TxRetriesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "app_tx_retries_total",
+			Help: "Количество повторов транзакций по SQLSTATE ошибки",
+		},
+		[]string{"code"},
+	)
+
+	TxRetriesExhaustedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "app_tx_retries_exhausted_total",
+			Help: "Количество транзакций, завершившихся ошибкой после всех повторов, по SQLSTATE ошибки",
+		},
+		[]string{"code"},
+	)
 )
+
+// TxRetryObserver передает повторы транзакций txs.TxManager в TxRetriesTotal и TxRetriesExhaustedTotal.
+type TxRetryObserver struct{}
+
+func (TxRetryObserver) TxRetried(code string) {
+	TxRetriesTotal.WithLabelValues(code).Inc()
+}
+
+func (TxRetryObserver) TxRetriesExhausted(code string) {
+	TxRetriesExhaustedTotal.WithLabelValues(code).Inc()
+}
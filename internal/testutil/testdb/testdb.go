@@ -0,0 +1,73 @@
+// Package testdb подключает интеграционные тесты к базе из TEST_DB_URL с примененными миграциями.
+// Без TEST_DB_URL такие тесты пропускаются, поэтому go test ./... проходит и без базы.
+package testdb
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// EnvURL - переменная окружения со строкой подключения к тестовой базе.
+const EnvURL = "TEST_DB_URL"
+
+// Pool подключается к тестовой базе и закрывает пул после теста. Без TEST_DB_URL тест пропускается.
+func Pool(tb testing.TB) *pgxpool.Pool {
+	tb.Helper()
+
+	return PoolWithConfig(tb, func(*pgxpool.Config) {})
+}
+
+// PoolWithConfig работает как Pool, но перед подключением передает конфигурацию пула в configure,
+// например чтобы подключить трассировку запросов.
+func PoolWithConfig(tb testing.TB, configure func(config *pgxpool.Config)) *pgxpool.Pool {
+	tb.Helper()
+
+	dbURL := os.Getenv(EnvURL)
+	if dbURL == "" {
+		tb.Skip(EnvURL + " не задан")
+	}
+
+	config, err := pgxpool.ParseConfig(dbURL)
+	require.NoError(tb, err)
+
+	configure(config)
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), config)
+	require.NoError(tb, err)
+	tb.Cleanup(pool.Close)
+
+	return pool
+}
+
+// CreatePVZ создает ПВЗ в городе city и удаляет его вместе с приемками и товарами после теста.
+func CreatePVZ(tb testing.TB, pool *pgxpool.Pool, city string) uuid.UUID {
+	tb.Helper()
+
+	ctx := context.Background()
+
+	var pvzID uuid.UUID
+
+	err := pool.QueryRow(ctx, `INSERT INTO pvz (city) VALUES ($1) RETURNING id`, city).Scan(&pvzID)
+	require.NoError(tb, err)
+
+	tb.Cleanup(func() {
+		_, err := pool.Exec(ctx, `
+			DELETE FROM products WHERE reception_id IN (SELECT id FROM receptions WHERE pvz_id = $1)
+		`, pvzID)
+		assert.NoError(tb, err)
+
+		_, err = pool.Exec(ctx, `DELETE FROM receptions WHERE pvz_id = $1`, pvzID)
+		assert.NoError(tb, err)
+
+		_, err = pool.Exec(ctx, `DELETE FROM pvz WHERE id = $1`, pvzID)
+		assert.NoError(tb, err)
+	})
+
+	return pvzID
+}
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_pvz_registration_date_id ON pvz(registration_date DESC, id DESC);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_reception_pvz_id_date_time_id;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_reception_pvz_id_date_time_id ON receptions(pvz_id, date_time DESC, id DESC);
@@ -0,0 +1,3 @@
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_reception_id_sequence_number_key;
+CREATE INDEX IF NOT EXISTS idx_product_sequence_number ON products(reception_id, sequence_number);
+ALTER TABLE receptions DROP COLUMN IF EXISTS last_product_sequence;
@@ -0,0 +1,22 @@
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS last_product_sequence INTEGER NOT NULL DEFAULT 0;
+
+-- Товары, получившие одинаковый номер при параллельном сканировании, перенумеровываются по времени добавления.
+UPDATE products p
+SET sequence_number = n.rn
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY reception_id ORDER BY sequence_number, date_time, id) AS rn
+    FROM products
+) n
+WHERE p.id = n.id AND p.sequence_number <> n.rn;
+
+UPDATE receptions r
+SET last_product_sequence = s.max_sequence
+FROM (
+    SELECT reception_id, MAX(sequence_number) AS max_sequence
+    FROM products
+    GROUP BY reception_id
+) s
+WHERE r.id = s.reception_id;
+
+DROP INDEX IF EXISTS idx_product_sequence_number;
+ALTER TABLE products ADD CONSTRAINT products_reception_id_sequence_number_key UNIQUE (reception_id, sequence_number);
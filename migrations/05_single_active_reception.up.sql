@@ -0,0 +1,23 @@
+-- Перед созданием индекса проверяем, что ни в одном ПВЗ нет нескольких незакрытых приемок.
+-- Такие ПВЗ перечисляются в ошибке; лишние приемки нужно закрыть вручную и повторить миграцию.
+DO $$
+DECLARE
+    duplicates TEXT;
+BEGIN
+    SELECT string_agg(format('%s (%s шт.)', pvz_id, cnt), ', ' ORDER BY pvz_id)
+    INTO duplicates
+    FROM (
+        SELECT pvz_id, COUNT(*) AS cnt
+        FROM receptions
+        WHERE status = 'in_progress'
+        GROUP BY pvz_id
+        HAVING COUNT(*) > 1
+    ) d;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'в ПВЗ несколько незакрытых приемок: %', duplicates
+            USING HINT = 'закройте все незакрытые приемки этих ПВЗ, кроме последней, и повторите миграцию';
+    END IF;
+END $$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reception_pvz_id_in_progress ON receptions(pvz_id) WHERE status = 'in_progress';
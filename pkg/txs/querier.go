@@ -23,3 +23,21 @@ func GetQuerier(ctx context.Context, defaultQuerier Querier) Querier {
 
 	return defaultQuerier
 }
+
+// GetReadQuerier выбирает исполнителя для чтения: транзакцию из ctx, иначе доступную реплику из replicas,
+// иначе primary. С WithPrimary чтение всегда идет в primary.
+func GetReadQuerier(ctx context.Context, primary Querier, replicas *ReplicaSet) Querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+
+	if forced, _ := ctx.Value(primaryKey{}).(bool); forced {
+		return primary
+	}
+
+	if q := replicas.pick(); q != nil {
+		return q
+	}
+
+	return primary
+}
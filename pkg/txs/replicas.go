@@ -0,0 +1,149 @@
+package txs
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+// Значения ReplicaConfig по умолчанию.
+const (
+	DefaultReplicaMaxLag        = 5 * time.Second
+	DefaultReplicaCheckInterval = 2 * time.Second
+)
+
+// replicaLagQuery возвращает, получает ли реплика WAL от primary, и ее отставание в секундах. Если реплика
+// применила все полученные записи WAL, отставание считается нулевым, иначе время последней примененной
+// транзакции росло бы при простое primary. Совпадение LSN не означает актуальности, если WAL receiver
+// отключен, поэтому без потоковой репликации реплика не принимает чтения.
+const replicaLagQuery = `
+    SELECT
+        COALESCE((SELECT status = 'streaming' FROM pg_stat_wal_receiver), false),
+        CASE
+            WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+            ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+        END
+`
+
+type primaryKey struct{}
+
+// WithPrimary возвращает контекст, в котором GetReadQuerier не использует реплики. Нужен для чтений,
+// которые должны видеть только что записанные данные, например для проверок перед записью.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// ReplicaConfig задает исключение отстающих реплик.
+type ReplicaConfig struct {
+	// MaxLag - максимальное отставание, при котором реплика принимает чтения.
+	MaxLag        time.Duration
+	CheckInterval time.Duration
+}
+
+type replica struct {
+	querier Querier
+	healthy atomic.Bool
+}
+
+// ReplicaSet распределяет чтения по репликам по кругу. Реплика принимает чтения, только пока проверка
+// в Run проходит и отставание не больше MaxLag; до первой проверки реплики не используются.
+// Методы nil *ReplicaSet безопасны: без реплик все чтения идут в primary.
+type ReplicaSet struct {
+	replicas []*replica
+	next     atomic.Uint64
+	cfg      ReplicaConfig
+	logger   *slog.Logger
+}
+
+func NewReplicaSet(replicas []Querier, cfg ReplicaConfig, logger *slog.Logger) *ReplicaSet {
+	if cfg.MaxLag <= 0 {
+		cfg.MaxLag = DefaultReplicaMaxLag
+	}
+
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = DefaultReplicaCheckInterval
+	}
+
+	s := &ReplicaSet{
+		replicas: make([]*replica, 0, len(replicas)),
+		cfg:      cfg,
+		logger:   logger,
+	}
+
+	for _, q := range replicas {
+		s.replicas = append(s.replicas, &replica{querier: q})
+	}
+
+	return s
+}
+
+// Run проверяет реплики сразу и далее с интервалом CheckInterval, пока не отменен ctx.
+func (s *ReplicaSet) Run(ctx context.Context) {
+	if s == nil || len(s.replicas) == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		for i, r := range s.replicas {
+			s.check(ctx, i, r)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ReplicaSet) check(ctx context.Context, index int, r *replica) {
+	checkCtx, cancel := context.WithTimeout(ctx, s.cfg.CheckInterval)
+	defer cancel()
+
+	var (
+		streaming  bool
+		lagSeconds float64
+	)
+
+	err := r.querier.QueryRow(checkCtx, replicaLagQuery).Scan(&streaming, &lagSeconds)
+	lag := time.Duration(lagSeconds * float64(time.Second))
+	healthy := err == nil && streaming && lag <= s.cfg.MaxLag
+
+	if r.healthy.Swap(healthy) == healthy {
+		return
+	}
+
+	switch {
+	case healthy:
+		s.logger.Info("Реплика возвращена в работу", "replica", index, "lag", lag)
+	case err != nil:
+		s.logger.Error("Реплика недоступна, чтения переведены на другие реплики", "replica", index, "error", err)
+	case !streaming:
+		s.logger.Error("Реплика не получает WAL от primary, чтения переведены на другие реплики", "replica", index)
+	default:
+		s.logger.Warn("Реплика отстает, чтения переведены на другие реплики", "replica", index, "lag", lag,
+			"max_lag", s.cfg.MaxLag)
+	}
+}
+
+// pick возвращает следующую по кругу доступную реплику или nil, если таких нет.
+func (s *ReplicaSet) pick() Querier {
+	if s == nil || len(s.replicas) == 0 {
+		return nil
+	}
+
+	n := uint64(len(s.replicas))
+	start := s.next.Add(1)
+
+	for i := range n {
+		if r := s.replicas[(start+i)%n]; r.healthy.Load() {
+			return r.querier
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package txs_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"avito/pkg/txs"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubQuerier отвечает на запрос отставания реплики значением lag или ошибкой, если failing.
+// С disconnected реплика сообщает, что WAL receiver не получает данные от primary.
+type stubQuerier struct {
+	lag          atomic.Int64
+	failing      atomic.Bool
+	disconnected atomic.Bool
+}
+
+type stubRow struct {
+	streaming bool
+	lag       time.Duration
+	err       error
+}
+
+func (r stubRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	*dest[0].(*bool) = r.streaming
+	*dest[1].(*float64) = r.lag.Seconds()
+
+	return nil
+}
+
+func (q *stubQuerier) Begin(context.Context) (pgx.Tx, error) {
+	return nil, errors.New("не поддерживается")
+}
+
+func (q *stubQuerier) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, errors.New("не поддерживается")
+}
+
+func (q *stubQuerier) Query(context.Context, string, ...any) (pgx.Rows, error) {
+	return nil, errors.New("не поддерживается")
+}
+
+func (q *stubQuerier) QueryRow(context.Context, string, ...any) pgx.Row {
+	if q.failing.Load() {
+		return stubRow{err: errors.New("реплика недоступна")}
+	}
+
+	return stubRow{streaming: !q.disconnected.Load(), lag: time.Duration(q.lag.Load())}
+}
+
+func newReplicaSet(replicas ...*stubQuerier) *txs.ReplicaSet {
+	queriers := make([]txs.Querier, 0, len(replicas))
+	for _, r := range replicas {
+		queriers = append(queriers, r)
+	}
+
+	return txs.NewReplicaSet(queriers, txs.ReplicaConfig{
+		MaxLag:        time.Second,
+		CheckInterval: 10 * time.Millisecond,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+// readQueriers возвращает исполнителей n последовательных чтений.
+func readQueriers(ctx context.Context, primary txs.Querier, replicas *txs.ReplicaSet, n int) []txs.Querier {
+	result := make([]txs.Querier, 0, n)
+	for range n {
+		result = append(result, txs.GetReadQuerier(ctx, primary, replicas))
+	}
+
+	return result
+}
+
+func TestGetReadQuerier_WithoutReplicas(t *testing.T) {
+	primary := &stubQuerier{}
+
+	assert.Same(t, primary, txs.GetReadQuerier(context.Background(), primary, nil))
+	assert.Same(t, primary, txs.GetReadQuerier(context.Background(), primary, newReplicaSet()))
+}
+
+func TestGetReadQuerier_Replicas(t *testing.T) {
+	primary, first, second := &stubQuerier{}, &stubQuerier{}, &stubQuerier{}
+	replicas := newReplicaSet(first, second)
+
+	// До первой проверки отставание реплик неизвестно.
+	assert.Same(t, primary, txs.GetReadQuerier(context.Background(), primary, replicas))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go replicas.Run(ctx)
+
+	assert.Eventually(t, func() bool {
+		reads := readQueriers(context.Background(), primary, replicas, 4)
+		return reads[0] != reads[1] && reads[0] == reads[2] && reads[1] == reads[3] && reads[0] != txs.Querier(primary)
+	}, time.Second, 10*time.Millisecond, "чтения должны чередоваться между репликами")
+
+	assert.Same(t, primary, txs.GetReadQuerier(txs.WithPrimary(context.Background()), primary, replicas))
+
+	second.lag.Store(int64(2 * time.Second))
+	assert.Eventually(t, func() bool {
+		for _, q := range readQueriers(ctx, primary, replicas, 3) {
+			if q != txs.Querier(first) {
+				return false
+			}
+		}
+
+		return true
+	}, time.Second, 10*time.Millisecond, "отстающая реплика должна исключаться")
+
+	second.lag.Store(0)
+	second.disconnected.Store(true)
+	assert.Eventually(t, func() bool {
+		for _, q := range readQueriers(ctx, primary, replicas, 3) {
+			if q != txs.Querier(first) {
+				return false
+			}
+		}
+
+		return true
+	}, time.Second, 10*time.Millisecond, "реплика без потоковой репликации должна исключаться")
+
+	second.disconnected.Store(false)
+	second.lag.Store(int64(2 * time.Second))
+
+	first.failing.Store(true)
+	assert.Eventually(t, func() bool {
+		return txs.GetReadQuerier(ctx, primary, replicas) == txs.Querier(primary)
+	}, time.Second, 10*time.Millisecond, "без доступных реплик чтения идут в primary")
+
+	second.lag.Store(0)
+	assert.Eventually(t, func() bool {
+		return txs.GetReadQuerier(ctx, primary, replicas) == txs.Querier(second)
+	}, time.Second, 10*time.Millisecond, "догнавшая реплика должна возвращаться")
+}
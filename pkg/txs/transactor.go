@@ -2,22 +2,94 @@ package txs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Коды SQLSTATE, при которых транзакция повторяется целиком.
+const (
+	CodeSerializationFailure = "40001"
+	CodeDeadlockDetected     = "40P01"
+)
+
+// Значения RetryPolicy по умолчанию.
+const (
+	DefaultMaxAttempts    = 3
+	DefaultRetryBaseDelay = 10 * time.Millisecond
+	DefaultRetryMaxDelay  = 200 * time.Millisecond
+)
+
+// RetryObserver получает уведомления о повторах транзакций, например для экспорта метрик.
+type RetryObserver interface {
+	// TxRetried вызывается перед повтором транзакции, завершившейся ошибкой с кодом code.
+	TxRetried(code string)
+	// TxRetriesExhausted вызывается, когда ошибка с кодом code возвращается после последней попытки.
+	TxRetriesExhausted(code string)
+}
+
+// RetryPolicy задает повторы транзакций при конфликтах сериализации и взаимоблокировках.
+// Задержка перед n-м повтором выбирается случайно из [d/2, d], где d = BaseDelay * 2^(n-1), но не больше MaxDelay.
+type RetryPolicy struct {
+	// MaxAttempts - общее число попыток, включая первую; 1 отключает повторы.
+	MaxAttempts int
+	// BaseDelay - задержка перед первым повтором; 0 - повторять сразу, без задержки.
+	BaseDelay time.Duration
+	// MaxDelay ограничивает задержку сверху; 0 также отключает задержку.
+	MaxDelay time.Duration
+	Observer RetryObserver
+}
+
+// DefaultRetryPolicy возвращает политику повторов по умолчанию без наблюдателя.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DefaultMaxAttempts,
+		BaseDelay:   DefaultRetryBaseDelay,
+		MaxDelay:    DefaultRetryMaxDelay,
+	}
+}
+
+// Delay возвращает задержку перед повтором с номером retry, начиная с 1.
+func (p RetryPolicy) Delay(retry int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	// Отрицательное значение означает переполнение сдвига при большом retry.
+	d := p.BaseDelay << (retry - 1)
+	if d > p.MaxDelay || d <= 0 {
+		d = p.MaxDelay
+	}
+
+	if d <= 0 {
+		return 0
+	}
+
+	//nolint:gosec // джиттер задержки не требует криптостойкого генератора
+	return d/2 + rand.N(d/2+1)
+}
+
 type TxManager struct {
 	db     *pgxpool.Pool
 	logger *slog.Logger
+	retry  RetryPolicy
 }
 
-func NewTxManager(db *pgxpool.Pool, logger *slog.Logger) *TxManager {
+func NewTxManager(db *pgxpool.Pool, logger *slog.Logger, retry RetryPolicy) *TxManager {
+	if retry.MaxAttempts < 1 {
+		retry.MaxAttempts = 1
+	}
+
 	return &TxManager{
 		db:     db,
 		logger: logger,
+		retry:  retry,
 	}
 }
 
@@ -25,14 +97,93 @@ func injectTx(ctx context.Context, tx pgx.Tx) context.Context {
 	return context.WithValue(ctx, txKey{}, tx)
 }
 
+type afterCommitKey struct{}
+
+// afterCommitHooks накапливает функции, отложенные до фиксации транзакции или точки сохранения.
+type afterCommitHooks struct {
+	fns []func()
+}
+
+// AfterCommit откладывает fn до фиксации внешней транзакции из ctx, например для публикации событий
+// о записанных в ней данных. При откате транзакции, точки сохранения с fn или попытки, которая будет
+// повторена, fn не вызывается. Вне транзакции fn вызывается сразу.
+func AfterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks)
+	if !ok {
+		fn()
+		return
+	}
+
+	hooks.fns = append(hooks.fns, fn)
+}
+
+// WithTransaction выполняет txFunc в транзакции с параметрами по умолчанию (READ COMMITTED, чтение и запись).
+// Как и WithTransactionOptions, при конфликте сериализации или взаимоблокировке она повторяет txFunc
+// до RetryPolicy.MaxAttempts раз, поэтому txFunc может выполниться несколько раз; действия вне базы данных
+// следует откладывать через AfterCommit. MaxAttempts 1 отключает повторы.
 func (t *TxManager) WithTransaction(ctx context.Context, txFunc func(ctx context.Context) error) error {
-	tx, err := t.db.Begin(ctx)
+	return t.WithTransactionOptions(ctx, pgx.TxOptions{}, txFunc)
+}
+
+// WithTransactionOptions выполняет txFunc в транзакции с уровнем изоляции и режимом доступа из opts.
+// При конфликте сериализации или взаимоблокировке транзакция откатывается и txFunc выполняется заново
+// в новой транзакции, поэтому txFunc не должна иметь побочных эффектов вне базы данных.
+//
+// Если ctx уже содержит транзакцию, txFunc выполняется в ней после SAVEPOINT: ошибка txFunc откатывает
+// только изменения после точки сохранения, а фиксирует их внешняя транзакция. opts в этом случае
+// не применяются, а повторы остаются за внешним вызовом, так как конфликт сериализации прерывает всю транзакцию.
+func (t *TxManager) WithTransactionOptions(ctx context.Context, opts pgx.TxOptions, txFunc func(ctx context.Context) error) error {
+	if outer, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return t.runTransaction(ctx, func() (pgx.Tx, error) { return outer.Begin(ctx) }, txFunc)
+	}
+
+	begin := func() (pgx.Tx, error) { return t.db.BeginTx(ctx, opts) }
+
+	for attempt := 1; ; attempt++ {
+		err := t.runTransaction(ctx, begin, txFunc)
+
+		code, retryable := retryableCode(err)
+		if !retryable {
+			return err
+		}
+
+		if attempt >= t.retry.MaxAttempts {
+			if t.retry.Observer != nil {
+				t.retry.Observer.TxRetriesExhausted(code)
+			}
+
+			return err
+		}
+
+		if t.retry.Observer != nil {
+			t.retry.Observer.TxRetried(code)
+		}
+
+		delay := t.retry.Delay(attempt)
+		t.logger.Warn("Повтор транзакции", "code", code, "attempt", attempt+1, "delay", delay)
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// runTransaction выполняет txFunc в транзакции или точке сохранения, созданной begin.
+// Для точки сохранения Commit и Rollback выполняют RELEASE SAVEPOINT и ROLLBACK TO SAVEPOINT.
+func (t *TxManager) runTransaction(ctx context.Context, begin func() (pgx.Tx, error), txFunc func(ctx context.Context) error) error {
+	tx, err := begin()
 	if err != nil {
 		t.logger.Error("Ошибка при начале транзакции", "error", err)
 		return fmt.Errorf("ошибка при начале транзакции: %w", err)
 	}
 
-	txCtx := injectTx(ctx, tx)
+	hooks := &afterCommitHooks{}
+	txCtx := context.WithValue(injectTx(ctx, tx), afterCommitKey{}, hooks)
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -60,5 +211,30 @@ func (t *TxManager) WithTransaction(ctx context.Context, txFunc func(ctx context
 		return fmt.Errorf("ошибка при commit транзакции: %w", err)
 	}
 
+	// После RELEASE SAVEPOINT изменения еще могут откатиться вместе с внешней транзакцией.
+	if outer, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks); ok {
+		outer.fns = append(outer.fns, hooks.fns...)
+		return nil
+	}
+
+	for _, fn := range hooks.fns {
+		fn()
+	}
+
 	return nil
 }
+
+// retryableCode возвращает SQLSTATE ошибки, если после нее транзакцию можно повторить.
+func retryableCode(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return "", false
+	}
+
+	switch pgErr.Code {
+	case CodeSerializationFailure, CodeDeadlockDetected:
+		return pgErr.Code, true
+	default:
+		return "", false
+	}
+}
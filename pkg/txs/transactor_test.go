@@ -0,0 +1,273 @@
+package txs_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"avito/internal/testutil/testdb"
+	"avito/pkg/txs"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Тестовые ПВЗ регистрируются в 2200 году, чтобы не пересекаться с данными в базе и другими тестами.
+var skewStart = time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type countingObserver struct {
+	retried   atomic.Int64
+	exhausted atomic.Int64
+}
+
+func (o *countingObserver) TxRetried(string) {
+	o.retried.Add(1)
+}
+
+func (o *countingObserver) TxRetriesExhausted(string) {
+	o.exhausted.Add(1)
+}
+
+// testPool подключается к тестовой базе и удаляет созданные тестом ПВЗ.
+func testPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	pool := testdb.Pool(t)
+
+	t.Cleanup(func() {
+		_, err := pool.Exec(context.Background(), `DELETE FROM pvz WHERE registration_date >= $1`, skewStart)
+		assert.NoError(t, err)
+	})
+
+	return pool
+}
+
+func newTxManager(pool *pgxpool.Pool, maxAttempts int, observer txs.RetryObserver) *txs.TxManager {
+	return txs.NewTxManager(pool, slog.New(slog.NewTextHandler(io.Discard, nil)), txs.RetryPolicy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    5 * time.Millisecond,
+		Observer:    observer,
+	})
+}
+
+// writeSkew запускает две SERIALIZABLE-транзакции, которые читают число тестовых ПВЗ и добавляют по одному.
+// Первые попытки дожидаются друг друга после чтения, поэтому одна из них гарантированно получает 40001.
+// Транзакция, завершившаяся до барьера, тоже отмечается на нем, чтобы вторая не ждала ее вечно.
+func writeSkew(t *testing.T, manager *txs.TxManager) []error {
+	t.Helper()
+
+	var (
+		wg       sync.WaitGroup
+		barrier  sync.WaitGroup
+		arrived  [2]sync.Once
+		errs     = make([]error, 2)
+		attempts [2]atomic.Int64
+	)
+
+	barrier.Add(len(errs))
+
+	for i := range errs {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer arrived[i].Do(barrier.Done)
+
+			opts := pgx.TxOptions{IsoLevel: pgx.Serializable}
+
+			errs[i] = manager.WithTransactionOptions(context.Background(), opts, func(ctx context.Context) error {
+				q := txs.GetQuerier(ctx, nil)
+
+				var count int
+				if err := q.QueryRow(ctx, `SELECT COUNT(*) FROM pvz WHERE registration_date >= $1`, skewStart).
+					Scan(&count); err != nil {
+					return err
+				}
+
+				if attempts[i].Add(1) == 1 {
+					arrived[i].Do(barrier.Done)
+					barrier.Wait()
+				}
+
+				_, err := q.Exec(ctx, `INSERT INTO pvz (registration_date, city) VALUES ($1, 'Казань')`,
+					skewStart.Add(time.Duration(count)*time.Hour))
+
+				return err
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := txs.RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+
+	assert.InDelta(t, 7500*time.Microsecond, policy.Delay(1), float64(2500*time.Microsecond))
+	assert.InDelta(t, 15*time.Millisecond, policy.Delay(2), float64(5*time.Millisecond))
+	assert.InDelta(t, 37500*time.Microsecond, policy.Delay(10), float64(12500*time.Microsecond), "не больше MaxDelay")
+	assert.InDelta(t, 37500*time.Microsecond, policy.Delay(100), float64(12500*time.Microsecond), "переполнение сдвига")
+
+	assert.Zero(t, txs.RetryPolicy{MaxDelay: time.Second}.Delay(3), "BaseDelay 0 отключает задержку")
+	assert.Zero(t, txs.RetryPolicy{BaseDelay: time.Millisecond}.Delay(1), "MaxDelay 0 отключает задержку")
+}
+
+func TestTxManager_RetriesSerializationFailure(t *testing.T) {
+	pool := testPool(t)
+	observer := &countingObserver{}
+
+	errs := writeSkew(t, newTxManager(pool, 3, observer))
+
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+
+	assert.Positive(t, observer.retried.Load())
+	assert.Zero(t, observer.exhausted.Load())
+}
+
+func TestTxManager_RetriesExhausted(t *testing.T) {
+	pool := testPool(t)
+	observer := &countingObserver{}
+
+	errs := writeSkew(t, newTxManager(pool, 1, observer))
+
+	failed := 0
+
+	for _, err := range errs {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			assert.Equal(t, txs.CodeSerializationFailure, pgErr.Code)
+
+			failed++
+		}
+	}
+
+	assert.Equal(t, 1, failed)
+	assert.Zero(t, observer.retried.Load())
+	assert.Equal(t, int64(1), observer.exhausted.Load())
+}
+
+func TestTxManager_ReadOnly(t *testing.T) {
+	pool := testPool(t)
+	observer := &countingObserver{}
+	manager := newTxManager(pool, 3, observer)
+
+	calls := 0
+	err := manager.WithTransactionOptions(context.Background(), pgx.TxOptions{AccessMode: pgx.ReadOnly},
+		func(ctx context.Context) error {
+			calls++
+
+			_, err := txs.GetQuerier(ctx, nil).Exec(ctx, `INSERT INTO pvz (registration_date, city) VALUES ($1, 'Казань')`,
+				skewStart)
+
+			return err
+		})
+
+	var pgErr *pgconn.PgError
+	require.ErrorAs(t, err, &pgErr)
+	assert.Equal(t, "25006", pgErr.Code, "запись в read-only транзакции")
+	assert.Equal(t, 1, calls, "ошибки, не связанные с конфликтами, не повторяются")
+	assert.Zero(t, observer.retried.Load())
+}
+
+func insertPVZ(ctx context.Context, offset time.Duration) error {
+	_, err := txs.GetQuerier(ctx, nil).Exec(ctx, `INSERT INTO pvz (registration_date, city) VALUES ($1, 'Казань')`,
+		skewStart.Add(offset))
+
+	return err
+}
+
+func testPVZCount(t *testing.T, pool *pgxpool.Pool) int {
+	t.Helper()
+
+	var count int
+	require.NoError(t, pool.QueryRow(context.Background(),
+		`SELECT COUNT(*) FROM pvz WHERE registration_date >= $1`, skewStart).Scan(&count))
+
+	return count
+}
+
+func TestTxManager_Nested(t *testing.T) {
+	errInner := errors.New("ошибка вложенной транзакции")
+
+	tests := []struct {
+		name     string
+		outerErr error
+		innerErr error
+		expected int
+		hooks    int
+	}{
+		{name: "Вложенная фиксируется вместе с внешней", expected: 2, hooks: 2},
+		{name: "Ошибка вложенной откатывает только ее", innerErr: errInner, expected: 1, hooks: 1},
+		{name: "Ошибка внешней откатывает и вложенную", outerErr: errors.New("ошибка внешней транзакции"), expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := testPool(t)
+			manager := newTxManager(pool, 1, nil)
+			hooks := 0
+
+			err := manager.WithTransaction(context.Background(), func(ctx context.Context) error {
+				if err := insertPVZ(ctx, 0); err != nil {
+					return err
+				}
+
+				txs.AfterCommit(ctx, func() { hooks++ })
+
+				innerErr := manager.WithTransaction(ctx, func(ctx context.Context) error {
+					if err := insertPVZ(ctx, time.Hour); err != nil {
+						return err
+					}
+
+					txs.AfterCommit(ctx, func() { hooks++ })
+
+					return tt.innerErr
+				})
+				if tt.innerErr != nil {
+					assert.ErrorIs(t, innerErr, tt.innerErr)
+				} else if innerErr != nil {
+					return innerErr
+				}
+
+				assert.Zero(t, hooks, "функции AfterCommit ждут фиксации внешней транзакции")
+
+				// После отката к точке сохранения внешняя транзакция остается рабочей.
+				if err := txs.GetQuerier(ctx, nil).QueryRow(ctx, `SELECT 1`).Scan(new(int)); err != nil {
+					return err
+				}
+
+				return tt.outerErr
+			})
+
+			if tt.outerErr != nil {
+				assert.ErrorIs(t, err, tt.outerErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expected, testPVZCount(t, pool))
+			assert.Equal(t, tt.hooks, hooks)
+		})
+	}
+}
+
+func TestAfterCommit_WithoutTransaction(t *testing.T) {
+	called := false
+
+	txs.AfterCommit(context.Background(), func() { called = true })
+
+	assert.True(t, called)
+}